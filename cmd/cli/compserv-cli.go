@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	api "github.com/rhmdnd/compserv/pkg/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// maxMessageSize matches the default limit of the server so we can send
+// large OSCAL documents.
+const maxMessageSize = 64 * 1024 * 1024
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, client api.ComplianceServiceClient, args []string) error
+}
+
+func getCommands() []command {
+	return []command{
+		{
+			name:  "import-catalog",
+			usage: "import-catalog FILE\n\tImport an OSCAL catalog in JSON format.",
+			run:   importCatalog,
+		},
+		{
+			name: "import-profile",
+			usage: "import-profile FILE HREF=CATALOG_ID...\n\tImport an OSCAL profile in JSON format, resolving each " +
+				"import\n\tagainst the catalog with the given ID.",
+			run: importProfile,
+		},
+		{
+			name: "import-mappings",
+			usage: "import-mappings [-source CATALOG_ID] [-target CATALOG_ID] FILE [HREF=CATALOG_ID...]\n\tImport a " +
+				"crosswalk between two catalogs. CSV files use the source and\n\ttarget catalogs, OSCAL mapping " +
+				"collections map each resource\n\thref to a catalog.",
+			run: importMappings,
+		},
+		{
+			name: "diff-assessments",
+			usage: "diff-assessments [-format text|markdown] BASE_ID TARGET_ID\n\tShow how the results of an " +
+				"assessment changed since an earlier\n\tassessment. Use the markdown format to paste the diff into a " +
+				"review.",
+			run: diffAssessments,
+		},
+		{
+			name: "export-assessment",
+			usage: "export-assessment [-plan HREF] [-o FILE] ID\n\tExport an assessment as an OSCAL assessment " +
+				"results document\n\tin JSON format. The document is written to stdout unless a file\n\tis given.",
+			run: exportAssessment,
+		},
+	}
+}
+
+func findCommand(name string) (command, bool) {
+	for _, c := range getCommands() {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "Address of the compliance service.")
+	timeout := flag.Duration("timeout", time.Minute, "Timeout for each request to the compliance service.")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+	c, ok := findCommand(flag.Arg(0))
+	if !ok {
+		fmt.Fprintf(flag.CommandLine.Output(), "Unknown command: %s\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, *addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxMessageSize), grpc.MaxCallRecvMsgSize(maxMessageSize)))
+	if err != nil {
+		log.Fatalf("Failed to connect to %s: %s", *addr, err)
+	}
+	defer conn.Close()
+
+	if err := c.run(ctx, api.NewComplianceServiceClient(conn), flag.Args()[1:]); err != nil {
+		log.Fatalf("%s failed: %s", flag.Arg(0), err)
+	}
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: %s [OPTIONS] COMMAND [ARGS]\n\nCommands:\n", os.Args[0])
+	for _, c := range getCommands() {
+		fmt.Fprintf(out, "  %s\n", c.usage)
+	}
+	fmt.Fprintf(out, "\nOptions:\n")
+	flag.PrintDefaults()
+}
+
+func importCatalog(ctx context.Context, client api.ComplianceServiceClient, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected a single catalog file, got %d arguments", len(args))
+	}
+	content, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to read catalog: %w", err)
+	}
+	response, err := client.ImportCatalog(ctx, &api.ImportCatalogRequest{Content: content})
+	if err != nil {
+		return err
+	}
+	action := "Imported"
+	if !response.Created {
+		action = "Updated"
+	}
+	fmt.Printf("%s catalog %s (%s) version %s with %d controls\n",
+		action, response.Name, response.CatalogId, response.Version, response.Controls)
+	return nil
+}
+
+func importProfile(ctx context.Context, client api.ComplianceServiceClient, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("expected a profile file and at least one catalog mapping, got %d arguments", len(args))
+	}
+	content, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to read profile: %w", err)
+	}
+	catalogIDs := map[string]string{}
+	for _, arg := range args[1:] {
+		href, id, ok := strings.Cut(arg, "=")
+		if !ok || href == "" || id == "" {
+			return fmt.Errorf("invalid catalog mapping %q, expected HREF=CATALOG_ID", arg)
+		}
+		catalogIDs[href] = id
+	}
+	response, err := client.ImportProfile(ctx, &api.ImportProfileRequest{Content: content, CatalogIds: catalogIDs})
+	if err != nil {
+		return err
+	}
+	action := "Imported"
+	if !response.Created {
+		action = "Updated"
+	}
+	fmt.Printf("%s profile %s (%s) version %s with %d controls from %d catalogs\n",
+		action, response.Name, response.ProfileId, response.Version, response.Controls, len(response.CatalogIds))
+	return nil
+}
+
+func importMappings(ctx context.Context, client api.ComplianceServiceClient, args []string) error {
+	fs := flag.NewFlagSet("import-mappings", flag.ContinueOnError)
+	source := fs.String("source", "", "ID of the catalog the source column of a CSV file refers to.")
+	target := fs.String("target", "", "ID of the catalog the target column of a CSV file refers to.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		return errors.New("expected a mapping file")
+	}
+	content, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("unable to read mappings: %w", err)
+	}
+	request := &api.ImportControlMappingsRequest{
+		Format:          api.MappingFormat_MAPPING_FORMAT_OSCAL,
+		Content:         content,
+		SourceCatalogId: *source,
+		TargetCatalogId: *target,
+		CatalogIds:      map[string]string{},
+	}
+	if strings.EqualFold(filepath.Ext(fs.Arg(0)), ".csv") {
+		request.Format = api.MappingFormat_MAPPING_FORMAT_CSV
+	}
+	for _, arg := range fs.Args()[1:] {
+		href, id, ok := strings.Cut(arg, "=")
+		if !ok || href == "" || id == "" {
+			return fmt.Errorf("invalid catalog mapping %q, expected HREF=CATALOG_ID", arg)
+		}
+		request.CatalogIds[href] = id
+	}
+	response, err := client.ImportControlMappings(ctx, request)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Imported %d control mappings\n", response.Mappings)
+	return nil
+}
+
+func diffAssessments(ctx context.Context, client api.ComplianceServiceClient, args []string) error {
+	fs := flag.NewFlagSet("diff-assessments", flag.ContinueOnError)
+	format := fs.String("format", "text", "Output format, either text or markdown.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return fmt.Errorf("expected a base and target assessment ID, got %d arguments", fs.NArg())
+	}
+	var write func(io.Writer, *api.DiffAssessmentsResponse)
+	switch *format {
+	case "text":
+		write = writeDiffText
+	case "markdown":
+		write = writeDiffMarkdown
+	default:
+		return fmt.Errorf("unknown format %q, expected text or markdown", *format)
+	}
+	response, err := client.DiffAssessments(ctx, &api.DiffAssessmentsRequest{
+		BaseAssessmentId:   fs.Arg(0),
+		TargetAssessmentId: fs.Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+	write(os.Stdout, response)
+	return nil
+}
+
+func exportAssessment(ctx context.Context, client api.ComplianceServiceClient, args []string) error {
+	fs := flag.NewFlagSet("export-assessment", flag.ContinueOnError)
+	plan := fs.String("plan", "", "Reference to the OSCAL assessment plan the assessment followed.")
+	output := fs.String("o", "", "File to write the document to.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("expected a single assessment ID, got %d arguments", fs.NArg())
+	}
+	response, err := client.ExportAssessmentResults(ctx, &api.ExportAssessmentResultsRequest{
+		Id:                 fs.Arg(0),
+		AssessmentPlanHref: *plan,
+	})
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = os.Stdout.Write(response.Content)
+		return err
+	}
+	if err := os.WriteFile(*output, response.Content, 0o600); err != nil {
+		return fmt.Errorf("unable to write assessment results: %w", err)
+	}
+	return nil
+}
+
+// diffSection is a category of changes in an assessment diff.
+type diffSection struct {
+	title string
+	diffs []*api.ResultDiff
+}
+
+func getDiffSections(d *api.DiffAssessmentsResponse) []diffSection {
+	return []diffSection{
+		{title: "Regressed", diffs: d.Regressed},
+		{title: "Fixed", diffs: d.Fixed},
+		{title: "Changed", diffs: d.Changed},
+		{title: "Added", diffs: d.Added},
+		{title: "Removed", diffs: d.Removed},
+	}
+}
+
+// diffOutcomes returns the outcome before and after the change, using "-"
+// for results that were added or removed.
+func diffOutcomes(d *api.ResultDiff) (string, string) {
+	before, after := "-", "-"
+	if d.Base != nil {
+		before = d.Base.Outcome
+	}
+	if d.Target != nil {
+		after = d.Target.Outcome
+	}
+	return before, after
+}
+
+func writeDiffText(w io.Writer, d *api.DiffAssessmentsResponse) {
+	for _, section := range getDiffSections(d) {
+		for _, diff := range section.diffs {
+			before, after := diffOutcomes(diff)
+			fmt.Fprintf(w, "%-9s %s %s %s: %s -> %s\n",
+				strings.ToUpper(section.title), diff.Subject, diff.Control, diff.Rule, before, after)
+		}
+	}
+	fmt.Fprintf(w, "%d regressed, %d fixed, %d changed, %d added, %d removed, %d unchanged\n",
+		len(d.Regressed), len(d.Fixed), len(d.Changed), len(d.Added), len(d.Removed), d.Unchanged)
+}
+
+func writeDiffMarkdown(w io.Writer, d *api.DiffAssessmentsResponse) {
+	fmt.Fprintf(w, "| Regressed | Fixed | Changed | Added | Removed | Unchanged |\n")
+	fmt.Fprintf(w, "| --- | --- | --- | --- | --- | --- |\n")
+	fmt.Fprintf(w, "| %d | %d | %d | %d | %d | %d |\n",
+		len(d.Regressed), len(d.Fixed), len(d.Changed), len(d.Added), len(d.Removed), d.Unchanged)
+	for _, section := range getDiffSections(d) {
+		if len(section.diffs) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n### %s (%d)\n\n", section.title, len(section.diffs))
+		fmt.Fprintf(w, "| Subject | Control | Rule | Before | After |\n")
+		fmt.Fprintf(w, "| --- | --- | --- | --- | --- |\n")
+		for _, diff := range section.diffs {
+			before, after := diffOutcomes(diff)
+			fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n", escapeMarkdown(diff.Subject),
+				escapeMarkdown(diff.Control), escapeMarkdown(diff.Rule), escapeMarkdown(before), escapeMarkdown(after))
+		}
+	}
+}
+
+// escapeMarkdown keeps values from breaking out of a markdown table cell.
+func escapeMarkdown(s string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(s)
+}
@@ -1,13 +1,19 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"net"
+	"net/http"
+	"time"
 
 	api "github.com/rhmdnd/compserv/pkg/api"
 	config "github.com/rhmdnd/compserv/pkg/config"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -33,9 +39,46 @@ func main() {
 		log.Fatalf("Failed to listen to %s: %v", appStr, err)
 	}
 
-	var opts []grpc.ServerOption
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(v.GetInt("app.max_message_size")),
+		grpc.MaxSendMsgSize(v.GetInt("app.max_message_size")),
+	}
 	grpcServer := grpc.NewServer(opts...)
-	api.RegisterComplianceServiceServer(grpcServer, api.NewServer(db))
+	store, err := config.GetEvidenceStore(v)
+	if err != nil {
+		log.Fatalf("Failed to open evidence store: %s", err)
+	}
+	api.RegisterComplianceServiceServer(grpcServer,
+		api.NewServer(db, api.WithEvidenceStore(store, v.GetInt64("evidence.max_size"))))
+	hs := api.NewHealthServer(context.Background(), db, v.GetDuration("app.health_check_interval"))
+	healthpb.RegisterHealthServer(grpcServer, hs)
+	if v.GetBool("app.reflection") {
+		reflection.Register(grpcServer)
+		log.Printf("Registered gRPC server reflection")
+	}
+
+	// The REST gateway proxies requests to the gRPC server, so it needs the
+	// same message size limits.
+	httpStr := v.GetString("app.host") + ":" + v.GetString("app.http_port")
+	gateway, err := api.NewGateway(context.Background(), appStr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(v.GetInt("app.max_message_size")),
+			grpc.MaxCallSendMsgSize(v.GetInt("app.max_message_size")),
+		),
+	)
+	if err != nil {
+		log.Fatalf("Failed to create REST gateway: %s", err)
+	}
+	// Only limit reading headers, watches stream responses indefinitely.
+	httpServer := &http.Server{Addr: httpStr, Handler: gateway, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		log.Printf("REST gateway listening on %s", httpStr)
+		if err := httpServer.ListenAndServe(); err != nil {
+			log.Fatalf("Failed to start REST gateway: %v", err)
+		}
+	}()
+
 	log.Printf("Server listening on %s", appStr)
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to start grpc server %v", err)
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_results_metadata_id;
+
+DROP INDEX IF EXISTS idx_results_assessment_id;
+
+DROP INDEX IF EXISTS idx_results_control_id;
+
+DROP INDEX IF EXISTS idx_results_subject_id;
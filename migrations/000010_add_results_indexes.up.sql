@@ -0,0 +1,7 @@
+CREATE INDEX IF NOT EXISTS idx_results_subject_id ON results (subject_id);
+
+CREATE INDEX IF NOT EXISTS idx_results_control_id ON results (control_id);
+
+CREATE INDEX IF NOT EXISTS idx_results_assessment_id ON results (assessment_id);
+
+CREATE INDEX IF NOT EXISTS idx_results_metadata_id ON results (metadata_id);
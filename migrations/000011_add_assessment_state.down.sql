@@ -0,0 +1,7 @@
+ALTER TABLE assessments DROP CONSTRAINT chk_assessments_state;
+
+ALTER TABLE assessments DROP COLUMN finished_at;
+
+ALTER TABLE assessments DROP COLUMN started_at;
+
+ALTER TABLE assessments DROP COLUMN state;
@@ -0,0 +1,15 @@
+ALTER TABLE assessments
+ADD COLUMN state VARCHAR(50) NOT NULL DEFAULT 'IN_PROGRESS';
+
+ALTER TABLE assessments
+ADD COLUMN started_at timestamp without time zone;
+
+ALTER TABLE assessments
+ADD COLUMN finished_at timestamp without time zone;
+
+ALTER TABLE assessments
+ADD CONSTRAINT chk_assessments_state CHECK (state IN ('IN_PROGRESS', 'COMPLETED', 'ABANDONED'));
+
+-- Assessments created before their state was tracked are already done.
+UPDATE assessments SET state = 'COMPLETED',
+finished_at = (SELECT updated_at FROM metadata WHERE metadata.id = assessments.metadata_id);
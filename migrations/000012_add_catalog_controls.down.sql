@@ -0,0 +1,13 @@
+ALTER TABLE controls DROP CONSTRAINT uq_controls_catalog_id_oscal_id;
+
+ALTER TABLE controls DROP CONSTRAINT fk_controls_catalog_id;
+
+ALTER TABLE controls DROP COLUMN title;
+
+ALTER TABLE controls DROP COLUMN oscal_id;
+
+ALTER TABLE controls DROP COLUMN catalog_id;
+
+ALTER TABLE catalogs DROP CONSTRAINT uq_catalogs_name_version;
+
+ALTER TABLE catalogs DROP COLUMN version;
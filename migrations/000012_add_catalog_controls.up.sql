@@ -0,0 +1,20 @@
+ALTER TABLE catalogs
+ADD COLUMN version VARCHAR(50);
+
+ALTER TABLE catalogs
+ADD CONSTRAINT uq_catalogs_name_version UNIQUE (name, version);
+
+ALTER TABLE controls
+ADD COLUMN catalog_id UUID;
+
+ALTER TABLE controls
+ADD COLUMN oscal_id VARCHAR(255);
+
+ALTER TABLE controls
+ADD COLUMN title TEXT;
+
+ALTER TABLE controls
+ADD CONSTRAINT fk_controls_catalog_id FOREIGN KEY (catalog_id) REFERENCES catalogs (id);
+
+ALTER TABLE controls
+ADD CONSTRAINT uq_controls_catalog_id_oscal_id UNIQUE (catalog_id, oscal_id);
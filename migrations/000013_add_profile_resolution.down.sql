@@ -0,0 +1,33 @@
+DROP INDEX IF EXISTS uq_controls_profile_id_catalog_id_oscal_id;
+
+DROP INDEX IF EXISTS uq_controls_catalog_id_oscal_id;
+
+-- Profile controls are copies of catalog controls, so results move to the
+-- catalog control before the copies are removed. Copies without a catalog
+-- control to move to are kept, but detached from the catalog like the
+-- profile controls created before this migration.
+UPDATE results SET control_id = catalog_controls.id
+FROM controls AS profile_controls, controls AS catalog_controls
+WHERE results.control_id = profile_controls.id
+AND profile_controls.profile_id IS NOT NULL
+AND catalog_controls.profile_id IS NULL
+AND catalog_controls.catalog_id = profile_controls.catalog_id
+AND catalog_controls.oscal_id = profile_controls.oscal_id;
+
+DELETE FROM controls WHERE profile_id IS NOT NULL AND catalog_id IS NOT NULL
+AND NOT EXISTS (SELECT 1 FROM results WHERE results.control_id = controls.id);
+
+UPDATE controls SET catalog_id = NULL WHERE profile_id IS NOT NULL;
+
+ALTER TABLE controls
+ADD CONSTRAINT uq_controls_catalog_id_oscal_id UNIQUE (catalog_id, oscal_id);
+
+DROP TABLE IF EXISTS profile_catalogs;
+
+ALTER TABLE profiles DROP CONSTRAINT uq_profiles_name_version;
+
+ALTER TABLE profiles DROP COLUMN parameters;
+
+ALTER TABLE profiles DROP COLUMN content;
+
+ALTER TABLE profiles DROP COLUMN version;
@@ -0,0 +1,31 @@
+ALTER TABLE profiles
+ADD COLUMN version VARCHAR(50);
+
+ALTER TABLE profiles
+ADD COLUMN content TEXT;
+
+ALTER TABLE profiles
+ADD COLUMN parameters TEXT;
+
+ALTER TABLE profiles
+ADD CONSTRAINT uq_profiles_name_version UNIQUE (name, version);
+
+CREATE TABLE IF NOT EXISTS profile_catalogs (
+  profile_id UUID NOT NULL,
+  catalog_id UUID NOT NULL,
+  href TEXT,
+  CONSTRAINT profile_catalogs_pkey PRIMARY KEY (profile_id, catalog_id),
+  CONSTRAINT fk_profile_catalogs_profile_id FOREIGN KEY (profile_id) REFERENCES profiles (id),
+  CONSTRAINT fk_profile_catalogs_catalog_id FOREIGN KEY (catalog_id) REFERENCES catalogs (id)
+);
+
+-- Controls selected by a profile are copies of the catalog controls with the
+-- profile_id set, so catalog controls are only unique among themselves and
+-- profile controls are unique within their profile.
+ALTER TABLE controls DROP CONSTRAINT uq_controls_catalog_id_oscal_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_controls_catalog_id_oscal_id
+ON controls (catalog_id, oscal_id) WHERE profile_id IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_controls_profile_id_catalog_id_oscal_id
+ON controls (profile_id, catalog_id, oscal_id) WHERE profile_id IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_results_control_id_subject_id_name;
+
+DROP INDEX IF EXISTS idx_controls_oscal_id;
+
+DROP INDEX IF EXISTS idx_controls_name;
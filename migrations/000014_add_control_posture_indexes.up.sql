@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS idx_controls_name ON controls (name);
+
+CREATE INDEX IF NOT EXISTS idx_controls_oscal_id ON controls (oscal_id);
+
+CREATE INDEX IF NOT EXISTS idx_results_control_id_subject_id_name ON results (control_id, subject_id, name);
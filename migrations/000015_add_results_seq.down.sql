@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_results_xid;
+
+ALTER TABLE results DROP COLUMN xid;
+
+DROP INDEX IF EXISTS idx_results_seq;
+
+ALTER TABLE results DROP COLUMN seq;
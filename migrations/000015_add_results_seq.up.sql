@@ -0,0 +1,19 @@
+-- seq orders results by when they were written, so watchers can page through
+-- them. xid is the transaction that wrote the result. seq is assigned before
+-- the transaction commits, so results can become visible out of seq order.
+-- Watchers resume from the oldest transaction that was still running instead,
+-- since everything written by older transactions is visible. The column is
+-- a 64-bit transaction ID, stored as a bigint so it can be compared with
+-- plain integers.
+ALTER TABLE results
+ADD COLUMN seq BIGSERIAL;
+
+CREATE INDEX IF NOT EXISTS idx_results_seq ON results (seq);
+
+ALTER TABLE results
+ADD COLUMN xid BIGINT;
+
+ALTER TABLE results
+ALTER COLUMN xid SET DEFAULT pg_current_xact_id()::text::bigint;
+
+CREATE INDEX IF NOT EXISTS idx_results_xid ON results (xid);
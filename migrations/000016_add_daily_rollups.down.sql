@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_metadata_created_at_day;
+
+DROP TABLE IF EXISTS stale_rollup_days;
+
+DROP TABLE IF EXISTS daily_compliance_rollups;
@@ -0,0 +1,32 @@
+-- daily_compliance_rollups counts result outcomes per day, subject and
+-- control so trends can be calculated without reading every result. Results
+-- are bucketed by the start of their assessment, or when they were reported
+-- if they don't belong to an assessment.
+CREATE TABLE IF NOT EXISTS daily_compliance_rollups (
+  day DATE NOT NULL,
+  subject_id UUID NOT NULL,
+  control_id UUID NOT NULL,
+  outcome VARCHAR(255) NOT NULL,
+  count BIGINT NOT NULL,
+  CONSTRAINT daily_compliance_rollups_pkey PRIMARY KEY (day, subject_id, control_id, outcome),
+  CONSTRAINT fk_daily_compliance_rollups_subject_id FOREIGN KEY (subject_id) REFERENCES subjects (id),
+  CONSTRAINT fk_daily_compliance_rollups_control_id FOREIGN KEY (control_id) REFERENCES controls (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_daily_compliance_rollups_subject_id_day ON daily_compliance_rollups (subject_id, day);
+
+-- stale_rollup_days tracks the days with rollups that need to be
+-- recalculated because results for that day changed.
+CREATE TABLE IF NOT EXISTS stale_rollup_days (
+  day DATE PRIMARY KEY,
+  marked_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_metadata_created_at_day ON metadata ((created_at::date));
+
+-- Calculate rollups for existing results the first time they're needed.
+INSERT INTO stale_rollup_days (day, marked_at)
+SELECT DISTINCT COALESCE(assessments.started_at, metadata.created_at)::date, now() FROM results
+LEFT JOIN assessments ON assessments.id = results.assessment_id
+LEFT JOIN metadata ON metadata.id = results.metadata_id
+WHERE COALESCE(assessments.started_at, metadata.created_at) IS NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE results DROP CONSTRAINT uq_results_idempotency_key;
+
+ALTER TABLE results DROP COLUMN request_hash;
+
+ALTER TABLE results DROP COLUMN idempotency_key;
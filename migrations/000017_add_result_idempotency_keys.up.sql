@@ -0,0 +1,10 @@
+-- idempotency_key is an optional key supplied by clients so retried requests
+-- don't create duplicate results. request_hash is a hash of the rest of the
+-- request, which lets us tell a retry from a different result that reuses
+-- the key.
+ALTER TABLE results
+ADD COLUMN idempotency_key VARCHAR(255),
+ADD COLUMN request_hash VARCHAR(64);
+
+ALTER TABLE results
+ADD CONSTRAINT uq_results_idempotency_key UNIQUE (idempotency_key);
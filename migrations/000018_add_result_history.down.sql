@@ -0,0 +1,5 @@
+-- Results that were deduplicated, or updated after this migration, aren't
+-- restored from their history.
+DROP INDEX IF EXISTS uq_results_subject_id_name_assessment_id;
+
+DROP TABLE IF EXISTS result_history;
@@ -0,0 +1,61 @@
+-- result_history keeps the previous values of results that were reported
+-- again during the same assessment, so changes stay auditable.
+CREATE TABLE IF NOT EXISTS result_history (
+  id BIGSERIAL PRIMARY KEY,
+  result_id UUID NOT NULL,
+  outcome VARCHAR(255),
+  instruction TEXT,
+  rationale TEXT,
+  control_id UUID,
+  metadata_id UUID,
+  idempotency_key VARCHAR(255),
+  request_hash VARCHAR(64),
+  replaced_at TIMESTAMP NOT NULL,
+  CONSTRAINT fk_result_history_result_id FOREIGN KEY (result_id) REFERENCES results (id),
+  CONSTRAINT fk_result_history_control_id FOREIGN KEY (control_id) REFERENCES controls (id),
+  CONSTRAINT fk_result_history_metadata_id FOREIGN KEY (metadata_id) REFERENCES metadata (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_result_history_result_id ON result_history (result_id);
+
+CREATE INDEX IF NOT EXISTS idx_result_history_idempotency_key ON result_history (idempotency_key);
+
+-- Keep the latest result of each subject and rule in an assessment, and move
+-- the rest to the history of the result we keep.
+CREATE TEMPORARY TABLE result_duplicates AS
+SELECT id, kept_id, created_at FROM (
+  SELECT results.id, metadata.created_at, first_value(results.id) OVER (
+    PARTITION BY results.subject_id, results.name, results.assessment_id
+    ORDER BY metadata.created_at DESC NULLS LAST, results.seq DESC
+  ) AS kept_id
+  FROM results
+  LEFT JOIN metadata ON metadata.id = results.metadata_id
+  WHERE results.assessment_id IS NOT NULL AND results.subject_id IS NOT NULL
+) AS ranked
+WHERE id <> kept_id;
+
+INSERT INTO stale_rollup_days (day, marked_at)
+SELECT DISTINCT COALESCE(assessments.started_at, metadata.created_at)::date, now() FROM results
+JOIN result_duplicates ON result_duplicates.id = results.id
+LEFT JOIN assessments ON assessments.id = results.assessment_id
+LEFT JOIN metadata ON metadata.id = results.metadata_id
+WHERE COALESCE(assessments.started_at, metadata.created_at) IS NOT NULL
+ON CONFLICT (day) DO UPDATE SET marked_at = excluded.marked_at;
+
+INSERT INTO result_history (
+  result_id, outcome, instruction, rationale, control_id, metadata_id, idempotency_key, request_hash, replaced_at
+)
+SELECT result_duplicates.kept_id, results.outcome, results.instruction, results.rationale, results.control_id,
+  results.metadata_id, results.idempotency_key, results.request_hash, now()
+FROM results
+JOIN result_duplicates ON result_duplicates.id = results.id
+ORDER BY result_duplicates.created_at NULLS FIRST, results.seq;
+
+DELETE FROM results WHERE id IN (SELECT id FROM result_duplicates);
+
+DROP TABLE result_duplicates;
+
+-- Results are only unique within an assessment. Results reported without an
+-- assessment are still appended.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_results_subject_id_name_assessment_id
+ON results (subject_id, name, assessment_id) WHERE assessment_id IS NOT NULL;
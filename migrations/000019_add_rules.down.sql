@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_results_rule_id;
+
+ALTER TABLE results DROP CONSTRAINT fk_results_rule_id;
+
+ALTER TABLE results DROP COLUMN rule_id;
+
+DROP TABLE IF EXISTS rule_controls;
+
+DROP TABLE IF EXISTS rules;
@@ -0,0 +1,40 @@
+-- rules are the checks scanners run. A rule can provide evidence for several
+-- controls, like a benchmark item that maps to both AC-2 and AC-6.
+CREATE TABLE IF NOT EXISTS rules (
+  id UUID PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  title VARCHAR(255),
+  description TEXT,
+  severity VARCHAR(50),
+  rationale TEXT,
+  check_metadata TEXT,
+  CONSTRAINT uq_rules_name UNIQUE (name)
+);
+
+CREATE TABLE IF NOT EXISTS rule_controls (
+  rule_id UUID NOT NULL,
+  control_id UUID NOT NULL,
+  CONSTRAINT rule_controls_pkey PRIMARY KEY (rule_id, control_id),
+  CONSTRAINT fk_rule_controls_rule_id FOREIGN KEY (rule_id) REFERENCES rules (id),
+  CONSTRAINT fk_rule_controls_control_id FOREIGN KEY (control_id) REFERENCES controls (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rule_controls_control_id ON rule_controls (control_id);
+
+ALTER TABLE results
+ADD COLUMN rule_id UUID;
+
+ALTER TABLE results
+ADD CONSTRAINT fk_results_rule_id FOREIGN KEY (rule_id) REFERENCES rules (id);
+
+CREATE INDEX IF NOT EXISTS idx_results_rule_id ON results (rule_id);
+
+-- Create rules for the existing results, and map them to the controls they
+-- were reported for.
+INSERT INTO rules (id, name)
+SELECT gen_random_uuid(), name FROM (SELECT DISTINCT name FROM results WHERE name IS NOT NULL) AS names;
+
+UPDATE results SET rule_id = rules.id FROM rules WHERE rules.name = results.name;
+
+INSERT INTO rule_controls (rule_id, control_id)
+SELECT DISTINCT rule_id, control_id FROM results WHERE rule_id IS NOT NULL AND control_id IS NOT NULL;
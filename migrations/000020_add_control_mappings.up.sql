@@ -0,0 +1,16 @@
+-- control_mappings crosswalk controls between catalogs, like a CIS benchmark
+-- item that's equivalent to a NIST SP 800-53 control. The relationship
+-- describes how much of the target control the source control covers.
+CREATE TABLE IF NOT EXISTS control_mappings (
+  id UUID PRIMARY KEY,
+  source_control_id UUID NOT NULL,
+  target_control_id UUID NOT NULL,
+  relationship VARCHAR(50) NOT NULL,
+  CONSTRAINT uq_control_mappings_source_control_id_target_control_id UNIQUE (source_control_id, target_control_id),
+  CONSTRAINT fk_control_mappings_source_control_id FOREIGN KEY (source_control_id) REFERENCES controls (id),
+  CONSTRAINT fk_control_mappings_target_control_id FOREIGN KEY (target_control_id) REFERENCES controls (id),
+  CONSTRAINT chk_control_mappings_relationship
+  CHECK (relationship IN ('EQUIVALENT', 'SUBSET', 'SUPERSET', 'INTERSECTS'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_control_mappings_target_control_id ON control_mappings (target_control_id);
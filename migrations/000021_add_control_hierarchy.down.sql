@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS idx_controls_family_id;
+
+DROP INDEX IF EXISTS idx_controls_parent_id;
+
+ALTER TABLE controls DROP CONSTRAINT fk_controls_family_id;
+
+ALTER TABLE controls DROP CONSTRAINT fk_controls_parent_id;
+
+ALTER TABLE controls DROP COLUMN position;
+
+ALTER TABLE controls DROP COLUMN family_id;
+
+ALTER TABLE controls DROP COLUMN parent_id;
+
+DROP TABLE IF EXISTS control_families;
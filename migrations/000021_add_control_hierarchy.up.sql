@@ -0,0 +1,35 @@
+-- control_families are the groups of a catalog that contain controls, like
+-- the Access Control family of NIST SP 800-53. Position orders them as they
+-- appear in the catalog.
+CREATE TABLE IF NOT EXISTS control_families (
+  id UUID PRIMARY KEY,
+  catalog_id UUID NOT NULL,
+  oscal_id VARCHAR(255) NOT NULL,
+  title VARCHAR(255),
+  position INTEGER NOT NULL DEFAULT 0,
+  CONSTRAINT uq_control_families_catalog_id_oscal_id UNIQUE (catalog_id, oscal_id),
+  CONSTRAINT fk_control_families_catalog_id FOREIGN KEY (catalog_id) REFERENCES catalogs (id)
+);
+
+-- Enhancements, like AC-2(1), reference the control they enhance. Profiles
+-- can stop selecting a base control while results still reference its
+-- enhancements, so the reference is cleared when the base control is
+-- deleted.
+ALTER TABLE controls
+ADD COLUMN parent_id UUID;
+
+ALTER TABLE controls
+ADD COLUMN family_id UUID;
+
+ALTER TABLE controls
+ADD COLUMN position INTEGER;
+
+ALTER TABLE controls
+ADD CONSTRAINT fk_controls_parent_id FOREIGN KEY (parent_id) REFERENCES controls (id) ON DELETE SET NULL;
+
+ALTER TABLE controls
+ADD CONSTRAINT fk_controls_family_id FOREIGN KEY (family_id) REFERENCES control_families (id);
+
+CREATE INDEX IF NOT EXISTS idx_controls_parent_id ON controls (parent_id);
+
+CREATE INDEX IF NOT EXISTS idx_controls_family_id ON controls (family_id);
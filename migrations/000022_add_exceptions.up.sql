@@ -0,0 +1,21 @@
+-- exceptions accept the risk of failing a control or rule on a subject and
+-- its descendants, like when a compensating control is in place. Failures
+-- they cover are reported as excepted until they expire or are revoked.
+-- Revoked exceptions are kept so there's a record of who approved them.
+CREATE TABLE IF NOT EXISTS exceptions (
+  id UUID PRIMARY KEY,
+  subject_id UUID NOT NULL,
+  control_id UUID,
+  rule_id UUID,
+  justification TEXT NOT NULL,
+  approver VARCHAR(255) NOT NULL,
+  expires_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  revoked_at TIMESTAMP,
+  CONSTRAINT fk_exceptions_subject_id FOREIGN KEY (subject_id) REFERENCES subjects (id),
+  CONSTRAINT fk_exceptions_control_id FOREIGN KEY (control_id) REFERENCES controls (id),
+  CONSTRAINT fk_exceptions_rule_id FOREIGN KEY (rule_id) REFERENCES rules (id),
+  CONSTRAINT chk_exceptions_control_id_rule_id CHECK (num_nonnulls(control_id, rule_id) = 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_exceptions_subject_id ON exceptions (subject_id);
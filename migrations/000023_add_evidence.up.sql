@@ -0,0 +1,21 @@
+-- evidence describes files attached to a result or an assessment, like the
+-- output of a check or a screenshot for a manual one. The contents are kept
+-- in a blob store under the evidence ID, so only the digest and size are
+-- stored here.
+CREATE TABLE IF NOT EXISTS evidence (
+  id UUID PRIMARY KEY,
+  result_id UUID,
+  assessment_id UUID,
+  name VARCHAR(255) NOT NULL,
+  content_type VARCHAR(255) NOT NULL,
+  sha256 VARCHAR(64) NOT NULL,
+  size BIGINT NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  CONSTRAINT fk_evidence_result_id FOREIGN KEY (result_id) REFERENCES results (id),
+  CONSTRAINT fk_evidence_assessment_id FOREIGN KEY (assessment_id) REFERENCES assessments (id),
+  CONSTRAINT chk_evidence_result_id_assessment_id CHECK (num_nonnulls(result_id, assessment_id) = 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_evidence_result_id ON evidence (result_id);
+
+CREATE INDEX IF NOT EXISTS idx_evidence_assessment_id ON evidence (assessment_id);
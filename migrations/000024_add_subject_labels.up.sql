@@ -0,0 +1,13 @@
+-- subject_labels tags subjects with key/value pairs, like env=prod, so
+-- queries can select subjects with Kubernetes-style label selectors. Keys and
+-- values follow the Kubernetes syntax, which limits their length. Subjects
+-- inherit the labels of their ancestors unless they set the key themselves.
+CREATE TABLE IF NOT EXISTS subject_labels (
+  subject_id UUID NOT NULL,
+  key VARCHAR(317) NOT NULL,
+  value VARCHAR(63) NOT NULL,
+  CONSTRAINT subject_labels_pkey PRIMARY KEY (subject_id, key),
+  CONSTRAINT fk_subject_labels_subject_id FOREIGN KEY (subject_id) REFERENCES subjects (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_subject_labels_key_value ON subject_labels (key, value);
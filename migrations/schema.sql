@@ -27,7 +27,11 @@ SET default_table_access_method = heap;
 CREATE TABLE public.assessments (
     id uuid NOT NULL,
     name character varying(255),
-    metadata_id uuid
+    metadata_id uuid,
+    state character varying(50) DEFAULT 'IN_PROGRESS'::character varying NOT NULL,
+    started_at timestamp without time zone,
+    finished_at timestamp without time zone,
+    CONSTRAINT chk_assessments_state CHECK (((state)::text = ANY ((ARRAY['IN_PROGRESS'::character varying, 'COMPLETED'::character varying, 'ABANDONED'::character varying])::text[])))
 );
 
 
@@ -41,12 +45,43 @@ CREATE TABLE public.catalogs (
     id uuid NOT NULL,
     name character varying(255),
     metadata_id uuid,
-    content text
+    content text,
+    version character varying(50)
 );
 
 
 ALTER TABLE public.catalogs OWNER TO dbadmin;
 
+--
+-- Name: control_families; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.control_families (
+    id uuid NOT NULL,
+    catalog_id uuid NOT NULL,
+    oscal_id character varying(255) NOT NULL,
+    title character varying(255),
+    "position" integer DEFAULT 0 NOT NULL
+);
+
+
+ALTER TABLE public.control_families OWNER TO dbadmin;
+
+--
+-- Name: control_mappings; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.control_mappings (
+    id uuid NOT NULL,
+    source_control_id uuid NOT NULL,
+    target_control_id uuid NOT NULL,
+    relationship character varying(50) NOT NULL,
+    CONSTRAINT chk_control_mappings_relationship CHECK (((relationship)::text = ANY ((ARRAY['EQUIVALENT'::character varying, 'SUBSET'::character varying, 'SUPERSET'::character varying, 'INTERSECTS'::character varying])::text[])))
+);
+
+
+ALTER TABLE public.control_mappings OWNER TO dbadmin;
+
 --
 -- Name: controls; Type: TABLE; Schema: public; Owner: dbadmin
 --
@@ -56,12 +91,72 @@ CREATE TABLE public.controls (
     name character varying(255),
     severity character varying(50),
     profile_id uuid,
-    metadata_id uuid
+    metadata_id uuid,
+    catalog_id uuid,
+    oscal_id character varying(255),
+    title text,
+    parent_id uuid,
+    family_id uuid,
+    "position" integer
 );
 
 
 ALTER TABLE public.controls OWNER TO dbadmin;
 
+--
+-- Name: daily_compliance_rollups; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.daily_compliance_rollups (
+    day date NOT NULL,
+    subject_id uuid NOT NULL,
+    control_id uuid NOT NULL,
+    outcome character varying(255) NOT NULL,
+    count bigint NOT NULL
+);
+
+
+ALTER TABLE public.daily_compliance_rollups OWNER TO dbadmin;
+
+--
+-- Name: evidence; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.evidence (
+    id uuid NOT NULL,
+    result_id uuid,
+    assessment_id uuid,
+    name character varying(255) NOT NULL,
+    content_type character varying(255) NOT NULL,
+    sha256 character varying(64) NOT NULL,
+    size bigint NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    CONSTRAINT chk_evidence_result_id_assessment_id CHECK ((num_nonnulls(result_id, assessment_id) = 1))
+);
+
+
+ALTER TABLE public.evidence OWNER TO dbadmin;
+
+--
+-- Name: exceptions; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.exceptions (
+    id uuid NOT NULL,
+    subject_id uuid NOT NULL,
+    control_id uuid,
+    rule_id uuid,
+    justification text NOT NULL,
+    approver character varying(255) NOT NULL,
+    expires_at timestamp without time zone NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    revoked_at timestamp without time zone,
+    CONSTRAINT chk_exceptions_control_id_rule_id CHECK ((num_nonnulls(control_id, rule_id) = 1))
+);
+
+
+ALTER TABLE public.exceptions OWNER TO dbadmin;
+
 --
 -- Name: metadata; Type: TABLE; Schema: public; Owner: dbadmin
 --
@@ -77,6 +172,19 @@ CREATE TABLE public.metadata (
 
 ALTER TABLE public.metadata OWNER TO dbadmin;
 
+--
+-- Name: profile_catalogs; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.profile_catalogs (
+    profile_id uuid NOT NULL,
+    catalog_id uuid NOT NULL,
+    href text
+);
+
+
+ALTER TABLE public.profile_catalogs OWNER TO dbadmin;
+
 --
 -- Name: profiles; Type: TABLE; Schema: public; Owner: dbadmin
 --
@@ -85,12 +193,55 @@ CREATE TABLE public.profiles (
     id uuid NOT NULL,
     name character varying(255),
     metadata_id uuid,
-    catalog_id uuid
+    catalog_id uuid,
+    version character varying(50),
+    content text,
+    parameters text
 );
 
 
 ALTER TABLE public.profiles OWNER TO dbadmin;
 
+--
+-- Name: result_history; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.result_history (
+    id bigint NOT NULL,
+    result_id uuid NOT NULL,
+    outcome character varying(255),
+    instruction text,
+    rationale text,
+    control_id uuid,
+    metadata_id uuid,
+    idempotency_key character varying(255),
+    request_hash character varying(64),
+    replaced_at timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.result_history OWNER TO dbadmin;
+
+--
+-- Name: result_history_id_seq; Type: SEQUENCE; Schema: public; Owner: dbadmin
+--
+
+CREATE SEQUENCE public.result_history_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.result_history_id_seq OWNER TO dbadmin;
+
+--
+-- Name: result_history_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: dbadmin
+--
+
+ALTER SEQUENCE public.result_history_id_seq OWNED BY public.result_history.id;
+
 --
 -- Name: results; Type: TABLE; Schema: public; Owner: dbadmin
 --
@@ -104,12 +255,66 @@ CREATE TABLE public.results (
     control_id uuid,
     metadata_id uuid,
     subject_id uuid,
-    assessment_id uuid
+    assessment_id uuid,
+    seq bigint NOT NULL,
+    xid bigint DEFAULT ((pg_current_xact_id())::text)::bigint,
+    idempotency_key character varying(255),
+    request_hash character varying(64),
+    rule_id uuid
 );
 
 
 ALTER TABLE public.results OWNER TO dbadmin;
 
+--
+-- Name: results_seq_seq; Type: SEQUENCE; Schema: public; Owner: dbadmin
+--
+
+CREATE SEQUENCE public.results_seq_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.results_seq_seq OWNER TO dbadmin;
+
+--
+-- Name: results_seq_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: dbadmin
+--
+
+ALTER SEQUENCE public.results_seq_seq OWNED BY public.results.seq;
+
+--
+-- Name: rule_controls; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.rule_controls (
+    rule_id uuid NOT NULL,
+    control_id uuid NOT NULL
+);
+
+
+ALTER TABLE public.rule_controls OWNER TO dbadmin;
+
+--
+-- Name: rules; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.rules (
+    id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    title character varying(255),
+    description text,
+    severity character varying(50),
+    rationale text,
+    check_metadata text
+);
+
+
+ALTER TABLE public.rules OWNER TO dbadmin;
+
 --
 -- Name: schema_migrations; Type: TABLE; Schema: public; Owner: dbadmin
 --
@@ -122,6 +327,31 @@ CREATE TABLE public.schema_migrations (
 
 ALTER TABLE public.schema_migrations OWNER TO dbadmin;
 
+--
+-- Name: stale_rollup_days; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.stale_rollup_days (
+    day date NOT NULL,
+    marked_at timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.stale_rollup_days OWNER TO dbadmin;
+
+--
+-- Name: subject_labels; Type: TABLE; Schema: public; Owner: dbadmin
+--
+
+CREATE TABLE public.subject_labels (
+    subject_id uuid NOT NULL,
+    key character varying(317) NOT NULL,
+    value character varying(63) NOT NULL
+);
+
+
+ALTER TABLE public.subject_labels OWNER TO dbadmin;
+
 --
 -- Name: subjects; Type: TABLE; Schema: public; Owner: dbadmin
 --
@@ -137,6 +367,20 @@ CREATE TABLE public.subjects (
 
 ALTER TABLE public.subjects OWNER TO dbadmin;
 
+--
+-- Name: result_history id; Type: DEFAULT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.result_history ALTER COLUMN id SET DEFAULT nextval('public.result_history_id_seq'::regclass);
+
+
+--
+-- Name: results seq; Type: DEFAULT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.results ALTER COLUMN seq SET DEFAULT nextval('public.results_seq_seq'::regclass);
+
+
 --
 -- Name: assessments assessments_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -153,6 +397,22 @@ ALTER TABLE ONLY public.catalogs
     ADD CONSTRAINT catalogs_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: control_families control_families_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.control_families
+    ADD CONSTRAINT control_families_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: control_mappings control_mappings_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.control_mappings
+    ADD CONSTRAINT control_mappings_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: controls controls_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -161,6 +421,30 @@ ALTER TABLE ONLY public.controls
     ADD CONSTRAINT controls_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: daily_compliance_rollups daily_compliance_rollups_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.daily_compliance_rollups
+    ADD CONSTRAINT daily_compliance_rollups_pkey PRIMARY KEY (day, subject_id, control_id, outcome);
+
+
+--
+-- Name: evidence evidence_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.evidence
+    ADD CONSTRAINT evidence_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: exceptions exceptions_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.exceptions
+    ADD CONSTRAINT exceptions_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: metadata metadata_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -169,6 +453,14 @@ ALTER TABLE ONLY public.metadata
     ADD CONSTRAINT metadata_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: profile_catalogs profile_catalogs_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.profile_catalogs
+    ADD CONSTRAINT profile_catalogs_pkey PRIMARY KEY (profile_id, catalog_id);
+
+
 --
 -- Name: profiles profiles_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -177,6 +469,14 @@ ALTER TABLE ONLY public.profiles
     ADD CONSTRAINT profiles_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: result_history result_history_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.result_history
+    ADD CONSTRAINT result_history_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: results results_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -185,6 +485,22 @@ ALTER TABLE ONLY public.results
     ADD CONSTRAINT results_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: rule_controls rule_controls_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.rule_controls
+    ADD CONSTRAINT rule_controls_pkey PRIMARY KEY (rule_id, control_id);
+
+
+--
+-- Name: rules rules_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.rules
+    ADD CONSTRAINT rules_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -193,6 +509,22 @@ ALTER TABLE ONLY public.schema_migrations
     ADD CONSTRAINT schema_migrations_pkey PRIMARY KEY (version);
 
 
+--
+-- Name: stale_rollup_days stale_rollup_days_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.stale_rollup_days
+    ADD CONSTRAINT stale_rollup_days_pkey PRIMARY KEY (day);
+
+
+--
+-- Name: subject_labels subject_labels_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.subject_labels
+    ADD CONSTRAINT subject_labels_pkey PRIMARY KEY (subject_id, key);
+
+
 --
 -- Name: subjects subjects_pkey; Type: CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -201,6 +533,229 @@ ALTER TABLE ONLY public.subjects
     ADD CONSTRAINT subjects_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: catalogs uq_catalogs_name_version; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.catalogs
+    ADD CONSTRAINT uq_catalogs_name_version UNIQUE (name, version);
+
+
+--
+-- Name: control_families uq_control_families_catalog_id_oscal_id; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.control_families
+    ADD CONSTRAINT uq_control_families_catalog_id_oscal_id UNIQUE (catalog_id, oscal_id);
+
+
+--
+-- Name: control_mappings uq_control_mappings_source_control_id_target_control_id; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.control_mappings
+    ADD CONSTRAINT uq_control_mappings_source_control_id_target_control_id UNIQUE (source_control_id, target_control_id);
+
+
+--
+-- Name: profiles uq_profiles_name_version; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.profiles
+    ADD CONSTRAINT uq_profiles_name_version UNIQUE (name, version);
+
+
+--
+-- Name: results uq_results_idempotency_key; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.results
+    ADD CONSTRAINT uq_results_idempotency_key UNIQUE (idempotency_key);
+
+
+--
+-- Name: rules uq_rules_name; Type: CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.rules
+    ADD CONSTRAINT uq_rules_name UNIQUE (name);
+
+
+--
+-- Name: idx_control_mappings_target_control_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_control_mappings_target_control_id ON public.control_mappings USING btree (target_control_id);
+
+
+--
+-- Name: idx_controls_family_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_controls_family_id ON public.controls USING btree (family_id);
+
+
+--
+-- Name: idx_controls_name; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_controls_name ON public.controls USING btree (name);
+
+
+--
+-- Name: idx_controls_oscal_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_controls_oscal_id ON public.controls USING btree (oscal_id);
+
+
+--
+-- Name: idx_controls_parent_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_controls_parent_id ON public.controls USING btree (parent_id);
+
+
+--
+-- Name: idx_daily_compliance_rollups_subject_id_day; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_daily_compliance_rollups_subject_id_day ON public.daily_compliance_rollups USING btree (subject_id, day);
+
+
+--
+-- Name: idx_evidence_assessment_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_evidence_assessment_id ON public.evidence USING btree (assessment_id);
+
+
+--
+-- Name: idx_evidence_result_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_evidence_result_id ON public.evidence USING btree (result_id);
+
+
+--
+-- Name: idx_exceptions_subject_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_exceptions_subject_id ON public.exceptions USING btree (subject_id);
+
+
+--
+-- Name: idx_metadata_created_at_day; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_metadata_created_at_day ON public.metadata USING btree (((created_at)::date));
+
+
+--
+-- Name: idx_result_history_idempotency_key; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_result_history_idempotency_key ON public.result_history USING btree (idempotency_key);
+
+
+--
+-- Name: idx_result_history_result_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_result_history_result_id ON public.result_history USING btree (result_id);
+
+
+--
+-- Name: idx_results_assessment_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_results_assessment_id ON public.results USING btree (assessment_id);
+
+
+--
+-- Name: idx_results_control_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_results_control_id ON public.results USING btree (control_id);
+
+
+--
+-- Name: idx_results_control_id_subject_id_name; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_results_control_id_subject_id_name ON public.results USING btree (control_id, subject_id, name);
+
+
+--
+-- Name: idx_results_metadata_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_results_metadata_id ON public.results USING btree (metadata_id);
+
+
+--
+-- Name: idx_results_rule_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_results_rule_id ON public.results USING btree (rule_id);
+
+
+--
+-- Name: idx_results_seq; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_results_seq ON public.results USING btree (seq);
+
+
+--
+-- Name: idx_results_subject_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_results_subject_id ON public.results USING btree (subject_id);
+
+
+--
+-- Name: idx_results_xid; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_results_xid ON public.results USING btree (xid);
+
+
+--
+-- Name: idx_rule_controls_control_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_rule_controls_control_id ON public.rule_controls USING btree (control_id);
+
+
+--
+-- Name: idx_subject_labels_key_value; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE INDEX idx_subject_labels_key_value ON public.subject_labels USING btree (key, value);
+
+
+--
+-- Name: uq_controls_catalog_id_oscal_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE UNIQUE INDEX uq_controls_catalog_id_oscal_id ON public.controls USING btree (catalog_id, oscal_id) WHERE (profile_id IS NULL);
+
+
+--
+-- Name: uq_controls_profile_id_catalog_id_oscal_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE UNIQUE INDEX uq_controls_profile_id_catalog_id_oscal_id ON public.controls USING btree (profile_id, catalog_id, oscal_id) WHERE (profile_id IS NOT NULL);
+
+
+--
+-- Name: uq_results_subject_id_name_assessment_id; Type: INDEX; Schema: public; Owner: dbadmin
+--
+
+CREATE UNIQUE INDEX uq_results_subject_id_name_assessment_id ON public.results USING btree (subject_id, name, assessment_id) WHERE (assessment_id IS NOT NULL);
+
+
 --
 -- Name: assessments fk_assessments_metadata_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -217,6 +772,46 @@ ALTER TABLE ONLY public.catalogs
     ADD CONSTRAINT fk_catalogs_metadata_id FOREIGN KEY (metadata_id) REFERENCES public.metadata(id);
 
 
+--
+-- Name: control_families fk_control_families_catalog_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.control_families
+    ADD CONSTRAINT fk_control_families_catalog_id FOREIGN KEY (catalog_id) REFERENCES public.catalogs(id);
+
+
+--
+-- Name: control_mappings fk_control_mappings_source_control_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.control_mappings
+    ADD CONSTRAINT fk_control_mappings_source_control_id FOREIGN KEY (source_control_id) REFERENCES public.controls(id);
+
+
+--
+-- Name: control_mappings fk_control_mappings_target_control_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.control_mappings
+    ADD CONSTRAINT fk_control_mappings_target_control_id FOREIGN KEY (target_control_id) REFERENCES public.controls(id);
+
+
+--
+-- Name: controls fk_controls_catalog_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.controls
+    ADD CONSTRAINT fk_controls_catalog_id FOREIGN KEY (catalog_id) REFERENCES public.catalogs(id);
+
+
+--
+-- Name: controls fk_controls_family_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.controls
+    ADD CONSTRAINT fk_controls_family_id FOREIGN KEY (family_id) REFERENCES public.control_families(id);
+
+
 --
 -- Name: controls fk_controls_metadata_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -225,6 +820,14 @@ ALTER TABLE ONLY public.controls
     ADD CONSTRAINT fk_controls_metadata_id FOREIGN KEY (metadata_id) REFERENCES public.metadata(id);
 
 
+--
+-- Name: controls fk_controls_parent_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.controls
+    ADD CONSTRAINT fk_controls_parent_id FOREIGN KEY (parent_id) REFERENCES public.controls(id) ON DELETE SET NULL;
+
+
 --
 -- Name: controls fk_controls_profile_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -233,6 +836,78 @@ ALTER TABLE ONLY public.controls
     ADD CONSTRAINT fk_controls_profile_id FOREIGN KEY (profile_id) REFERENCES public.profiles(id);
 
 
+--
+-- Name: daily_compliance_rollups fk_daily_compliance_rollups_control_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.daily_compliance_rollups
+    ADD CONSTRAINT fk_daily_compliance_rollups_control_id FOREIGN KEY (control_id) REFERENCES public.controls(id);
+
+
+--
+-- Name: daily_compliance_rollups fk_daily_compliance_rollups_subject_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.daily_compliance_rollups
+    ADD CONSTRAINT fk_daily_compliance_rollups_subject_id FOREIGN KEY (subject_id) REFERENCES public.subjects(id);
+
+
+--
+-- Name: evidence fk_evidence_assessment_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.evidence
+    ADD CONSTRAINT fk_evidence_assessment_id FOREIGN KEY (assessment_id) REFERENCES public.assessments(id);
+
+
+--
+-- Name: evidence fk_evidence_result_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.evidence
+    ADD CONSTRAINT fk_evidence_result_id FOREIGN KEY (result_id) REFERENCES public.results(id);
+
+
+--
+-- Name: exceptions fk_exceptions_control_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.exceptions
+    ADD CONSTRAINT fk_exceptions_control_id FOREIGN KEY (control_id) REFERENCES public.controls(id);
+
+
+--
+-- Name: exceptions fk_exceptions_rule_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.exceptions
+    ADD CONSTRAINT fk_exceptions_rule_id FOREIGN KEY (rule_id) REFERENCES public.rules(id);
+
+
+--
+-- Name: exceptions fk_exceptions_subject_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.exceptions
+    ADD CONSTRAINT fk_exceptions_subject_id FOREIGN KEY (subject_id) REFERENCES public.subjects(id);
+
+
+--
+-- Name: profile_catalogs fk_profile_catalogs_catalog_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.profile_catalogs
+    ADD CONSTRAINT fk_profile_catalogs_catalog_id FOREIGN KEY (catalog_id) REFERENCES public.catalogs(id);
+
+
+--
+-- Name: profile_catalogs fk_profile_catalogs_profile_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.profile_catalogs
+    ADD CONSTRAINT fk_profile_catalogs_profile_id FOREIGN KEY (profile_id) REFERENCES public.profiles(id);
+
+
 --
 -- Name: profiles fk_profiles_catalog_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -249,6 +924,30 @@ ALTER TABLE ONLY public.profiles
     ADD CONSTRAINT fk_profiles_metadata_id FOREIGN KEY (metadata_id) REFERENCES public.metadata(id);
 
 
+--
+-- Name: result_history fk_result_history_control_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.result_history
+    ADD CONSTRAINT fk_result_history_control_id FOREIGN KEY (control_id) REFERENCES public.controls(id);
+
+
+--
+-- Name: result_history fk_result_history_metadata_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.result_history
+    ADD CONSTRAINT fk_result_history_metadata_id FOREIGN KEY (metadata_id) REFERENCES public.metadata(id);
+
+
+--
+-- Name: result_history fk_result_history_result_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.result_history
+    ADD CONSTRAINT fk_result_history_result_id FOREIGN KEY (result_id) REFERENCES public.results(id);
+
+
 --
 -- Name: results fk_results_assessment_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -273,6 +972,14 @@ ALTER TABLE ONLY public.results
     ADD CONSTRAINT fk_results_metadata_id FOREIGN KEY (metadata_id) REFERENCES public.metadata(id);
 
 
+--
+-- Name: results fk_results_rule_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.results
+    ADD CONSTRAINT fk_results_rule_id FOREIGN KEY (rule_id) REFERENCES public.rules(id);
+
+
 --
 -- Name: results fk_results_subject_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
 --
@@ -281,6 +988,30 @@ ALTER TABLE ONLY public.results
     ADD CONSTRAINT fk_results_subject_id FOREIGN KEY (subject_id) REFERENCES public.subjects(id);
 
 
+--
+-- Name: rule_controls fk_rule_controls_control_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.rule_controls
+    ADD CONSTRAINT fk_rule_controls_control_id FOREIGN KEY (control_id) REFERENCES public.controls(id);
+
+
+--
+-- Name: rule_controls fk_rule_controls_rule_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.rule_controls
+    ADD CONSTRAINT fk_rule_controls_rule_id FOREIGN KEY (rule_id) REFERENCES public.rules(id);
+
+
+--
+-- Name: subject_labels fk_subject_labels_subject_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
+--
+
+ALTER TABLE ONLY public.subject_labels
+    ADD CONSTRAINT fk_subject_labels_subject_id FOREIGN KEY (subject_id) REFERENCES public.subjects(id);
+
+
 --
 -- Name: subjects fk_subjects_metadata_id; Type: FK CONSTRAINT; Schema: public; Owner: dbadmin
 --
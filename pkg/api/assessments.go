@@ -0,0 +1,265 @@
+package compserv
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// These are the values stored in the assessments.state column.
+const (
+	assessmentInProgress = "IN_PROGRESS"
+	assessmentCompleted  = "COMPLETED"
+	assessmentAbandoned  = "ABANDONED"
+)
+
+var assessmentStates = map[string]AssessmentState{
+	assessmentInProgress: AssessmentState_ASSESSMENT_STATE_IN_PROGRESS,
+	assessmentCompleted:  AssessmentState_ASSESSMENT_STATE_COMPLETED,
+	assessmentAbandoned:  AssessmentState_ASSESSMENT_STATE_ABANDONED,
+}
+
+var assessmentStateValues = map[AssessmentState]string{
+	AssessmentState_ASSESSMENT_STATE_IN_PROGRESS: assessmentInProgress,
+	AssessmentState_ASSESSMENT_STATE_COMPLETED:   assessmentCompleted,
+	AssessmentState_ASSESSMENT_STATE_ABANDONED:   assessmentAbandoned,
+}
+
+func toAssessmentMessage(a *models.Assessment) *Assessment {
+	m := &Assessment{
+		Id:    a.ID,
+		Name:  a.Name.String,
+		State: assessmentStates[a.State],
+	}
+	if a.StartedAt.Valid {
+		m.StartedAt = timestamppb.New(a.StartedAt.Time)
+	}
+	if a.FinishedAt.Valid {
+		m.FinishedAt = timestamppb.New(a.FinishedAt.Time)
+	}
+	return m
+}
+
+func getAssessment(tx *gorm.DB, id string) (*models.Assessment, error) {
+	a := &models.Assessment{}
+	err := tx.Where("id = ?", id).Take(a).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "assessment %s does not exist", id)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to lookup assessment %s: %w", id, err)
+	}
+	return a, nil
+}
+
+// findAssessment makes sure the assessment exists and can accept results.
+// Results aren't required to belong to an assessment, so an empty ID isn't an
+// error. The assessment row is locked for the rest of the transaction so it
+// can't be closed while we're adding results to it.
+func findAssessment(tx *gorm.DB, id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+	a, err := getAssessment(tx.Clauses(clause.Locking{Strength: "SHARE"}), id)
+	if status.Code(err) == codes.NotFound {
+		return "", invalidField("assessmentId", "assessment %s does not exist", id)
+	} else if err != nil {
+		return "", err
+	}
+	if a.State != assessmentInProgress {
+		return "", status.Errorf(codes.FailedPrecondition,
+			"assessment %s is closed and can't accept new results", id)
+	}
+	return a.ID, nil
+}
+
+func (s *server) OpenAssessment(ctx context.Context, request *OpenAssessmentRequest) (*Assessment, error) {
+	if err := checkLength("name", request.GetName(), maxNameLength); err != nil {
+		return nil, err
+	}
+	a := &models.Assessment{
+		ID:        uuid.NewString(),
+		Name:      toNullString(request.GetName()),
+		State:     assessmentInProgress,
+		StartedAt: sql.NullTime{Time: time.Now().UTC(), Valid: true},
+	}
+	if err := s.database.WithContext(ctx).Create(a).Error; err != nil {
+		return nil, toStatusError(fmt.Errorf("failed to create assessment: %w", err))
+	}
+	return toAssessmentMessage(a), nil
+}
+
+func (s *server) GetAssessment(ctx context.Context, request *GetAssessmentRequest) (*Assessment, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	a, err := getAssessment(s.database.WithContext(ctx), request.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toAssessmentMessage(a), nil
+}
+
+func (s *server) ListAssessments(ctx context.Context,
+	request *ListAssessmentsRequest,
+) (*ListAssessmentsResponse, error) {
+	after, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	size := getPageSize(request.GetPageSize())
+
+	q := s.database.WithContext(ctx)
+	if request.GetState() != AssessmentState_ASSESSMENT_STATE_UNSPECIFIED {
+		state, ok := assessmentStateValues[request.GetState()]
+		if !ok {
+			return nil, invalidField("state", "unknown assessment state %d", request.GetState())
+		}
+		q = q.Where("state = ?", state)
+	}
+	if after != "" {
+		q = q.Where("id > ?", after)
+	}
+	var assessments []models.Assessment
+	if err := q.Order("id").Limit(size + 1).Find(&assessments).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &ListAssessmentsResponse{}
+	if len(assessments) > size {
+		assessments = assessments[:size]
+		response.NextPageToken = encodePageToken(assessments[size-1].ID)
+	}
+	for i := range assessments {
+		response.Assessments = append(response.Assessments, toAssessmentMessage(&assessments[i]))
+	}
+	return response, nil
+}
+
+func (s *server) AttachResults(ctx context.Context, request *AttachResultsRequest) (*AttachResultsResponse, error) {
+	if err := checkUUID("assessmentId", request.GetAssessmentId()); err != nil {
+		return nil, err
+	}
+	for i, id := range request.GetResultIds() {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, invalidField(fmt.Sprintf("resultIds[%d]", i), "result ID %q is not a valid UUID", id)
+		}
+	}
+
+	response := &AttachResultsResponse{}
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := findAssessment(tx, request.GetAssessmentId()); err != nil {
+			return err
+		}
+		var results []models.Result
+		if err := tx.Where("id IN ?", request.GetResultIds()).Find(&results).Error; err != nil {
+			return fmt.Errorf("failed to lookup results: %w", err)
+		}
+		found := map[string]bool{}
+		var attached []string
+		for _, r := range results {
+			found[r.ID] = true
+			if !r.AssessmentID.Valid {
+				attached = append(attached, r.ID)
+			}
+			if r.AssessmentID.Valid && r.AssessmentID.String != request.GetAssessmentId() {
+				return status.Errorf(codes.FailedPrecondition,
+					"result %s already belongs to assessment %s", r.ID, r.AssessmentID.String)
+			}
+		}
+		for _, id := range request.GetResultIds() {
+			if !found[id] {
+				return status.Errorf(codes.NotFound, "result %s does not exist", id)
+			}
+		}
+
+		// Assessments only keep one result for each subject and rule.
+		var duplicates []struct {
+			SubjectID string
+			Name      string
+		}
+		q := tx.Model(&models.Result{}).Select("subject_id, name").
+			Where("subject_id IS NOT NULL").
+			Where("(id IN ? AND assessment_id IS NULL) OR assessment_id = ?", request.GetResultIds(), request.GetAssessmentId()).
+			Group("subject_id, name").Having("COUNT(*) > 1").Limit(1)
+		if err := q.Scan(&duplicates).Error; err != nil {
+			return fmt.Errorf("failed to lookup duplicate results: %w", err)
+		}
+		if len(duplicates) > 0 {
+			return status.Errorf(codes.FailedPrecondition,
+				"assessment %s would have more than one result for subject %s and rule %s",
+				request.GetAssessmentId(), duplicates[0].SubjectID, duplicates[0].Name)
+		}
+
+		// Attached results count towards the day the assessment
+		// started, so both the old and new days need new rollups.
+		if err := markRollupsStale(tx, request.GetResultIds()); err != nil {
+			return err
+		}
+		// Attached results are sent to watchers again, since they may
+		// only be watching the assessment.
+		q = tx.Table("results").Where("id IN ?", attached).Where("assessment_id IS NULL").Updates(map[string]interface{}{
+			"assessment_id": request.GetAssessmentId(),
+			"seq":           gorm.Expr("nextval(pg_get_serial_sequence('results', 'seq'))"),
+			"xid":           gorm.Expr("pg_current_xact_id()::text::bigint"),
+		})
+		if q.Error != nil {
+			return fmt.Errorf("failed to attach results to assessment %s: %w", request.GetAssessmentId(), q.Error)
+		}
+		response.Attached = q.RowsAffected
+		if err := markRollupsStale(tx, request.GetResultIds()); err != nil {
+			return err
+		}
+		return notifyResults(tx, attached)
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return response, nil
+}
+
+func (s *server) CloseAssessment(ctx context.Context, request *CloseAssessmentRequest) (*Assessment, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	state := assessmentCompleted
+	if request.GetAbandoned() {
+		state = assessmentAbandoned
+	}
+
+	var a *models.Assessment
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		a, err = getAssessment(tx.Clauses(clause.Locking{Strength: "UPDATE"}), request.GetId())
+		if err != nil {
+			return err
+		}
+		if a.State != assessmentInProgress {
+			return status.Errorf(codes.FailedPrecondition, "assessment %s is already closed", a.ID)
+		}
+		a.State = state
+		a.FinishedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+		if err := tx.Save(a).Error; err != nil {
+			return fmt.Errorf("failed to close assessment %s: %w", a.ID, err)
+		}
+		if state == assessmentAbandoned {
+			// Results from abandoned assessments don't count towards
+			// trends.
+			return markRollupsStale(tx, tx.Model(&models.Result{}).Select("id").Where("assessment_id = ?", a.ID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toAssessmentMessage(a), nil
+}
@@ -0,0 +1,134 @@
+package compserv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	oscal "github.com/rhmdnd/compserv/pkg/oscal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// controlBatchSize is the number of controls inserted per statement when
+// importing a catalog. Large catalogs, like NIST SP 800-53, have over a
+// thousand controls and enhancements.
+const controlBatchSize = 500
+
+func (s *server) ImportCatalog(ctx context.Context, request *ImportCatalogRequest) (*ImportCatalogResponse, error) {
+	c, err := oscal.ParseCatalog(request.GetContent())
+	if err != nil {
+		return nil, invalidField("content", "%s", err)
+	}
+	if len(c.Metadata.Title) > maxNameLength {
+		return nil, invalidField("content", "catalog title must be %d characters or less", maxNameLength)
+	}
+	if len(c.Metadata.Version) > maxVersionLength {
+		return nil, invalidField("content", "catalog version must be %d characters or less", maxVersionLength)
+	}
+	controls := c.Flatten()
+	for _, ctl := range controls {
+		if len(ctl.Label) > maxNameLength || len(ctl.ID) > maxNameLength {
+			return nil, invalidField("content",
+				"control %s identifiers must be %d characters or less", ctl.ID, maxNameLength)
+		}
+		if len(ctl.GroupID) > maxNameLength || len(ctl.GroupTitle) > maxNameLength {
+			return nil, invalidField("content",
+				"group %s identifier and title must be %d characters or less", ctl.GroupID, maxNameLength)
+		}
+	}
+
+	var response *ImportCatalogResponse
+	err = s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		catalog, created, err := findOrCreateCatalog(tx, c, request.GetContent())
+		if err != nil {
+			return err
+		}
+		if err := upsertCatalogControls(tx, catalog.ID, controls); err != nil {
+			return err
+		}
+		response = &ImportCatalogResponse{
+			CatalogId: catalog.ID,
+			Name:      catalog.Name,
+			Version:   catalog.Version.String,
+			Controls:  int64(len(controls)),
+			Created:   created,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return response, nil
+}
+
+// findOrCreateCatalog stores the raw catalog document. Catalogs are unique
+// by name and version, so importing a catalog a second time replaces the
+// content of the existing catalog instead of creating a new one.
+func findOrCreateCatalog(tx *gorm.DB, c *oscal.Catalog, content []byte) (*models.Catalog, bool, error) {
+	catalog := &models.Catalog{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("name = ? AND version = ?", c.Metadata.Title, c.Metadata.Version).Take(catalog).Error
+	if err == nil {
+		catalog.Content = toNullString(string(content))
+		if err := tx.Save(catalog).Error; err != nil {
+			return nil, false, fmt.Errorf("failed to update catalog %s: %w", catalog.ID, err)
+		}
+		return catalog, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, fmt.Errorf("failed to lookup catalog %s: %w", c.Metadata.Title, err)
+	}
+
+	now := time.Now().UTC()
+	md := &models.Metadata{
+		ID:        uuid.NewString(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   toNullString(c.Metadata.Version),
+	}
+	if err := tx.Create(md).Error; err != nil {
+		return nil, false, fmt.Errorf("failed to create metadata: %w", err)
+	}
+	catalog = &models.Catalog{
+		ID:         uuid.NewString(),
+		Name:       c.Metadata.Title,
+		MetadataID: toNullString(md.ID),
+		Content:    toNullString(string(content)),
+		Version:    toNullString(c.Metadata.Version),
+	}
+	if err := tx.Create(catalog).Error; err != nil {
+		return nil, false, fmt.Errorf("failed to create catalog %s: %w", c.Metadata.Title, err)
+	}
+	return catalog, true, nil
+}
+
+// upsertCatalogControls creates a control for every control in the catalog,
+// or updates it if it was created by a previous import. Controls reference
+// their family and the control they enhance.
+func upsertCatalogControls(tx *gorm.DB, catalogID string, controls []oscal.FlatControl) error {
+	if len(controls) == 0 {
+		return nil
+	}
+	families, err := upsertControlFamilies(tx, catalogID, controls)
+	if err != nil {
+		return err
+	}
+	existing, err := controlIDs(tx.Where("catalog_id = ? AND profile_id IS NULL", catalogID))
+	if err != nil {
+		return err
+	}
+	rows := controlRows(models.Control{CatalogID: toNullString(catalogID)}, controls, existing, families)
+	err = tx.Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "catalog_id"}, {Name: "oscal_id"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "profile_id IS NULL"}}},
+		DoUpdates:   clause.AssignmentColumns(controlImportColumns),
+	}).CreateInBatches(rows, controlBatchSize).Error
+	if err != nil {
+		return fmt.Errorf("failed to create controls for catalog %s: %w", catalogID, err)
+	}
+	return nil
+}
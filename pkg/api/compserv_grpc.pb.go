@@ -22,7 +22,133 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ComplianceServiceClient interface {
+	// SetResult persists a result. Results are unique for each subject
+	// and rule within an assessment, so reporting a check again during
+	// the same assessment updates the original result and keeps the
+	// previous values as history.
 	SetResult(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+	// SetResults accepts a stream of results and persists them in
+	// batches. Invalid results are rejected individually without
+	// affecting the rest of the stream. Retried results with an
+	// idempotency key are counted as accepted.
+	SetResults(ctx context.Context, opts ...grpc.CallOption) (ComplianceService_SetResultsClient, error)
+	GetResult(ctx context.Context, in *GetResultRequest, opts ...grpc.CallOption) (*Result, error)
+	// ListResults returns results matching all of the provided
+	// filters. Results are returned in pages, use the nextPageToken
+	// from a response to fetch the following page.
+	ListResults(ctx context.Context, in *ListResultsRequest, opts ...grpc.CallOption) (*ListResultsResponse, error)
+	CreateSubject(ctx context.Context, in *CreateSubjectRequest, opts ...grpc.CallOption) (*Subject, error)
+	GetSubject(ctx context.Context, in *GetSubjectRequest, opts ...grpc.CallOption) (*Subject, error)
+	UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpc.CallOption) (*Subject, error)
+	ListSubjects(ctx context.Context, in *ListSubjectsRequest, opts ...grpc.CallOption) (*ListSubjectsResponse, error)
+	DeleteSubject(ctx context.Context, in *DeleteSubjectRequest, opts ...grpc.CallOption) (*DeleteSubjectResponse, error)
+	// ListSubjectDescendants walks the subject hierarchy and returns
+	// every subject below the given subject.
+	ListSubjectDescendants(ctx context.Context, in *ListSubjectDescendantsRequest, opts ...grpc.CallOption) (*ListSubjectDescendantsResponse, error)
+	// SetSubjectLabels adds labels to a subject, replacing the value of
+	// keys the subject already has.
+	SetSubjectLabels(ctx context.Context, in *SetSubjectLabelsRequest, opts ...grpc.CallOption) (*Subject, error)
+	// RemoveSubjectLabels removes labels from a subject by key. Keys the
+	// subject doesn't have are ignored.
+	RemoveSubjectLabels(ctx context.Context, in *RemoveSubjectLabelsRequest, opts ...grpc.CallOption) (*Subject, error)
+	// OpenAssessment starts a new assessment. Results can be added to
+	// the assessment until it's closed.
+	OpenAssessment(ctx context.Context, in *OpenAssessmentRequest, opts ...grpc.CallOption) (*Assessment, error)
+	GetAssessment(ctx context.Context, in *GetAssessmentRequest, opts ...grpc.CallOption) (*Assessment, error)
+	ListAssessments(ctx context.Context, in *ListAssessmentsRequest, opts ...grpc.CallOption) (*ListAssessmentsResponse, error)
+	// AttachResults adds existing results to an open assessment.
+	AttachResults(ctx context.Context, in *AttachResultsRequest, opts ...grpc.CallOption) (*AttachResultsResponse, error)
+	// CloseAssessment marks an assessment as completed or abandoned.
+	// Closed assessments don't accept new results.
+	CloseAssessment(ctx context.Context, in *CloseAssessmentRequest, opts ...grpc.CallOption) (*Assessment, error)
+	// ImportCatalog stores an OSCAL catalog and creates a control for
+	// every control and control enhancement in the catalog. Importing
+	// the same catalog version again updates the existing controls.
+	ImportCatalog(ctx context.Context, in *ImportCatalogRequest, opts ...grpc.CallOption) (*ImportCatalogResponse, error)
+	// ImportProfile stores an OSCAL profile and resolves it against
+	// catalogs that were already imported. Every control the profile
+	// selects is stored as a control of the profile.
+	ImportProfile(ctx context.Context, in *ImportProfileRequest, opts ...grpc.CallOption) (*ImportProfileResponse, error)
+	// QueryControlPosture returns the subjects with results for a
+	// control, along with the latest result of every rule checked for
+	// that control. Results from abandoned assessments are ignored.
+	QueryControlPosture(ctx context.Context, in *QueryControlPostureRequest, opts ...grpc.CallOption) (*QueryControlPostureResponse, error)
+	// WatchResults streams results matching a filter as they're
+	// persisted. The first message doesn't include a result and marks
+	// the start of the live feed. Pass the last resume token received
+	// to a new call to replay results missed while disconnected.
+	// Results are sent as their transactions commit, so results written
+	// by transactions that were still running when the token was issued
+	// may be sent again after resuming.
+	WatchResults(ctx context.Context, in *WatchResultsRequest, opts ...grpc.CallOption) (ComplianceService_WatchResultsClient, error)
+	// ExportResults streams the results matching a filter as CSV or
+	// JSON Lines, for loading into spreadsheets and notebooks. Rows are
+	// read from a database cursor and sent in chunks as they're
+	// encoded, so exports don't have to fit in memory. The gateway
+	// would wrap every chunk in a JSON message, so instead of an HTTP
+	// option the export is downloaded from GET /v1/results:export,
+	// which takes the same query parameters as ListResults.
+	ExportResults(ctx context.Context, in *ExportResultsRequest, opts ...grpc.CallOption) (ComplianceService_ExportResultsClient, error)
+	// GetComplianceSummary counts result outcomes, optionally grouped.
+	// Subject groups include the results of every descendant of the
+	// subject. Results from abandoned assessments are ignored.
+	GetComplianceSummary(ctx context.Context, in *GetComplianceSummaryRequest, opts ...grpc.CallOption) (*GetComplianceSummaryResponse, error)
+	// DiffAssessments compares the results of two assessments, like two
+	// scans of the same cluster. Results are matched by subject, control
+	// and rule.
+	DiffAssessments(ctx context.Context, in *DiffAssessmentsRequest, opts ...grpc.CallOption) (*DiffAssessmentsResponse, error)
+	// GetComplianceTrend returns the pass rate over time, bucketed by
+	// day or week. Results are bucketed by the start of their
+	// assessment, or when they were reported if they don't belong to an
+	// assessment. Results from abandoned assessments are ignored.
+	GetComplianceTrend(ctx context.Context, in *GetComplianceTrendRequest, opts ...grpc.CallOption) (*GetComplianceTrendResponse, error)
+	// SetRule creates or updates a rule by name, along with the
+	// controls it provides evidence for.
+	SetRule(ctx context.Context, in *SetRuleRequest, opts ...grpc.CallOption) (*Rule, error)
+	GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*Rule, error)
+	// ListRules returns rules in pages, optionally only the ones mapped
+	// to a control.
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	// ImportControlMappings stores a crosswalk between the controls of
+	// two catalogs, like a CIS benchmark and NIST SP 800-53. Importing a
+	// mapping again replaces its relationship.
+	ImportControlMappings(ctx context.Context, in *ImportControlMappingsRequest, opts ...grpc.CallOption) (*ImportControlMappingsResponse, error)
+	// ListControlMappings returns crosswalk mappings in pages,
+	// optionally only the ones for a control.
+	ListControlMappings(ctx context.Context, in *ListControlMappingsRequest, opts ...grpc.CallOption) (*ListControlMappingsResponse, error)
+	// ExportAssessmentResults renders an assessment as an OSCAL
+	// assessment results document, so it can be handed to auditors.
+	// Subjects become inventory items, each result an observation, and
+	// each control a finding. Resources keep their IDs as OSCAL UUIDs.
+	ExportAssessmentResults(ctx context.Context, in *ExportAssessmentResultsRequest, opts ...grpc.CallOption) (*ExportAssessmentResultsResponse, error)
+	// CreateException accepts failures of a control or rule on a subject
+	// and its descendants until the exception expires. Failures it
+	// covers are reported as EXCEPTED by GetComplianceSummary and
+	// QueryControlPosture instead of FAIL.
+	CreateException(ctx context.Context, in *CreateExceptionRequest, opts ...grpc.CallOption) (*Exception, error)
+	GetException(ctx context.Context, in *GetExceptionRequest, opts ...grpc.CallOption) (*Exception, error)
+	// ListExceptions returns exceptions in pages, including expired and
+	// revoked ones unless a state is provided.
+	ListExceptions(ctx context.Context, in *ListExceptionsRequest, opts ...grpc.CallOption) (*ListExceptionsResponse, error)
+	// RevokeException ends an exception before it expires. Revoked
+	// exceptions are kept so there's a record of them.
+	RevokeException(ctx context.Context, in *RevokeExceptionRequest, opts ...grpc.CallOption) (*Exception, error)
+	// UploadEvidence attaches a file to a result or an assessment, like
+	// the output of a check. The first message describes the evidence
+	// and the contents follow in any number of messages. The contents
+	// are kept in the configured blob store rather than the database.
+	// Over HTTP, POST the contents to /v1/evidence with the fields of
+	// the metadata as query parameters and the Content-Type header.
+	UploadEvidence(ctx context.Context, opts ...grpc.CallOption) (ComplianceService_UploadEvidenceClient, error)
+	GetEvidence(ctx context.Context, in *GetEvidenceRequest, opts ...grpc.CallOption) (*Evidence, error)
+	// ListEvidence returns evidence in pages, optionally only the
+	// evidence attached to a result or an assessment.
+	ListEvidence(ctx context.Context, in *ListEvidenceRequest, opts ...grpc.CallOption) (*ListEvidenceResponse, error)
+	// DownloadEvidence streams the contents of evidence. The first
+	// message describes the evidence. Over HTTP, GET
+	// /v1/evidence/{id}:download returns the contents as a file.
+	DownloadEvidence(ctx context.Context, in *DownloadEvidenceRequest, opts ...grpc.CallOption) (ComplianceService_DownloadEvidenceClient, error)
+	DeleteEvidence(ctx context.Context, in *DeleteEvidenceRequest, opts ...grpc.CallOption) (*DeleteEvidenceResponse, error)
 }
 
 type complianceServiceClient struct {
@@ -42,48 +168,1488 @@ func (c *complianceServiceClient) SetResult(ctx context.Context, in *ResultReque
 	return out, nil
 }
 
+func (c *complianceServiceClient) SetResults(ctx context.Context, opts ...grpc.CallOption) (ComplianceService_SetResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ComplianceService_ServiceDesc.Streams[0], "/ComplianceService/SetResults", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &complianceServiceSetResultsClient{stream}
+	return x, nil
+}
+
+type ComplianceService_SetResultsClient interface {
+	Send(*ResultRequest) error
+	CloseAndRecv() (*SetResultsResponse, error)
+	grpc.ClientStream
+}
+
+type complianceServiceSetResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *complianceServiceSetResultsClient) Send(m *ResultRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *complianceServiceSetResultsClient) CloseAndRecv() (*SetResultsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SetResultsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *complianceServiceClient) GetResult(ctx context.Context, in *GetResultRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/ComplianceService/GetResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ListResults(ctx context.Context, in *ListResultsRequest, opts ...grpc.CallOption) (*ListResultsResponse, error) {
+	out := new(ListResultsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ListResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) CreateSubject(ctx context.Context, in *CreateSubjectRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, "/ComplianceService/CreateSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) GetSubject(ctx context.Context, in *GetSubjectRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, "/ComplianceService/GetSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, "/ComplianceService/UpdateSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ListSubjects(ctx context.Context, in *ListSubjectsRequest, opts ...grpc.CallOption) (*ListSubjectsResponse, error) {
+	out := new(ListSubjectsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ListSubjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) DeleteSubject(ctx context.Context, in *DeleteSubjectRequest, opts ...grpc.CallOption) (*DeleteSubjectResponse, error) {
+	out := new(DeleteSubjectResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/DeleteSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ListSubjectDescendants(ctx context.Context, in *ListSubjectDescendantsRequest, opts ...grpc.CallOption) (*ListSubjectDescendantsResponse, error) {
+	out := new(ListSubjectDescendantsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ListSubjectDescendants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) SetSubjectLabels(ctx context.Context, in *SetSubjectLabelsRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, "/ComplianceService/SetSubjectLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) RemoveSubjectLabels(ctx context.Context, in *RemoveSubjectLabelsRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, "/ComplianceService/RemoveSubjectLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) OpenAssessment(ctx context.Context, in *OpenAssessmentRequest, opts ...grpc.CallOption) (*Assessment, error) {
+	out := new(Assessment)
+	err := c.cc.Invoke(ctx, "/ComplianceService/OpenAssessment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) GetAssessment(ctx context.Context, in *GetAssessmentRequest, opts ...grpc.CallOption) (*Assessment, error) {
+	out := new(Assessment)
+	err := c.cc.Invoke(ctx, "/ComplianceService/GetAssessment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ListAssessments(ctx context.Context, in *ListAssessmentsRequest, opts ...grpc.CallOption) (*ListAssessmentsResponse, error) {
+	out := new(ListAssessmentsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ListAssessments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) AttachResults(ctx context.Context, in *AttachResultsRequest, opts ...grpc.CallOption) (*AttachResultsResponse, error) {
+	out := new(AttachResultsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/AttachResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) CloseAssessment(ctx context.Context, in *CloseAssessmentRequest, opts ...grpc.CallOption) (*Assessment, error) {
+	out := new(Assessment)
+	err := c.cc.Invoke(ctx, "/ComplianceService/CloseAssessment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ImportCatalog(ctx context.Context, in *ImportCatalogRequest, opts ...grpc.CallOption) (*ImportCatalogResponse, error) {
+	out := new(ImportCatalogResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ImportCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ImportProfile(ctx context.Context, in *ImportProfileRequest, opts ...grpc.CallOption) (*ImportProfileResponse, error) {
+	out := new(ImportProfileResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ImportProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) QueryControlPosture(ctx context.Context, in *QueryControlPostureRequest, opts ...grpc.CallOption) (*QueryControlPostureResponse, error) {
+	out := new(QueryControlPostureResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/QueryControlPosture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) WatchResults(ctx context.Context, in *WatchResultsRequest, opts ...grpc.CallOption) (ComplianceService_WatchResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ComplianceService_ServiceDesc.Streams[1], "/ComplianceService/WatchResults", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &complianceServiceWatchResultsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ComplianceService_WatchResultsClient interface {
+	Recv() (*WatchResultsResponse, error)
+	grpc.ClientStream
+}
+
+type complianceServiceWatchResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *complianceServiceWatchResultsClient) Recv() (*WatchResultsResponse, error) {
+	m := new(WatchResultsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *complianceServiceClient) ExportResults(ctx context.Context, in *ExportResultsRequest, opts ...grpc.CallOption) (ComplianceService_ExportResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ComplianceService_ServiceDesc.Streams[2], "/ComplianceService/ExportResults", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &complianceServiceExportResultsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ComplianceService_ExportResultsClient interface {
+	Recv() (*ExportResultsResponse, error)
+	grpc.ClientStream
+}
+
+type complianceServiceExportResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *complianceServiceExportResultsClient) Recv() (*ExportResultsResponse, error) {
+	m := new(ExportResultsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *complianceServiceClient) GetComplianceSummary(ctx context.Context, in *GetComplianceSummaryRequest, opts ...grpc.CallOption) (*GetComplianceSummaryResponse, error) {
+	out := new(GetComplianceSummaryResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/GetComplianceSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) DiffAssessments(ctx context.Context, in *DiffAssessmentsRequest, opts ...grpc.CallOption) (*DiffAssessmentsResponse, error) {
+	out := new(DiffAssessmentsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/DiffAssessments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) GetComplianceTrend(ctx context.Context, in *GetComplianceTrendRequest, opts ...grpc.CallOption) (*GetComplianceTrendResponse, error) {
+	out := new(GetComplianceTrendResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/GetComplianceTrend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) SetRule(ctx context.Context, in *SetRuleRequest, opts ...grpc.CallOption) (*Rule, error) {
+	out := new(Rule)
+	err := c.cc.Invoke(ctx, "/ComplianceService/SetRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) GetRule(ctx context.Context, in *GetRuleRequest, opts ...grpc.CallOption) (*Rule, error) {
+	out := new(Rule)
+	err := c.cc.Invoke(ctx, "/ComplianceService/GetRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ListRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ImportControlMappings(ctx context.Context, in *ImportControlMappingsRequest, opts ...grpc.CallOption) (*ImportControlMappingsResponse, error) {
+	out := new(ImportControlMappingsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ImportControlMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ListControlMappings(ctx context.Context, in *ListControlMappingsRequest, opts ...grpc.CallOption) (*ListControlMappingsResponse, error) {
+	out := new(ListControlMappingsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ListControlMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ExportAssessmentResults(ctx context.Context, in *ExportAssessmentResultsRequest, opts ...grpc.CallOption) (*ExportAssessmentResultsResponse, error) {
+	out := new(ExportAssessmentResultsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ExportAssessmentResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) CreateException(ctx context.Context, in *CreateExceptionRequest, opts ...grpc.CallOption) (*Exception, error) {
+	out := new(Exception)
+	err := c.cc.Invoke(ctx, "/ComplianceService/CreateException", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) GetException(ctx context.Context, in *GetExceptionRequest, opts ...grpc.CallOption) (*Exception, error) {
+	out := new(Exception)
+	err := c.cc.Invoke(ctx, "/ComplianceService/GetException", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ListExceptions(ctx context.Context, in *ListExceptionsRequest, opts ...grpc.CallOption) (*ListExceptionsResponse, error) {
+	out := new(ListExceptionsResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ListExceptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) RevokeException(ctx context.Context, in *RevokeExceptionRequest, opts ...grpc.CallOption) (*Exception, error) {
+	out := new(Exception)
+	err := c.cc.Invoke(ctx, "/ComplianceService/RevokeException", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) UploadEvidence(ctx context.Context, opts ...grpc.CallOption) (ComplianceService_UploadEvidenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ComplianceService_ServiceDesc.Streams[3], "/ComplianceService/UploadEvidence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &complianceServiceUploadEvidenceClient{stream}
+	return x, nil
+}
+
+type ComplianceService_UploadEvidenceClient interface {
+	Send(*UploadEvidenceRequest) error
+	CloseAndRecv() (*Evidence, error)
+	grpc.ClientStream
+}
+
+type complianceServiceUploadEvidenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *complianceServiceUploadEvidenceClient) Send(m *UploadEvidenceRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *complianceServiceUploadEvidenceClient) CloseAndRecv() (*Evidence, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Evidence)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *complianceServiceClient) GetEvidence(ctx context.Context, in *GetEvidenceRequest, opts ...grpc.CallOption) (*Evidence, error) {
+	out := new(Evidence)
+	err := c.cc.Invoke(ctx, "/ComplianceService/GetEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) ListEvidence(ctx context.Context, in *ListEvidenceRequest, opts ...grpc.CallOption) (*ListEvidenceResponse, error) {
+	out := new(ListEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/ListEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complianceServiceClient) DownloadEvidence(ctx context.Context, in *DownloadEvidenceRequest, opts ...grpc.CallOption) (ComplianceService_DownloadEvidenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ComplianceService_ServiceDesc.Streams[4], "/ComplianceService/DownloadEvidence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &complianceServiceDownloadEvidenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ComplianceService_DownloadEvidenceClient interface {
+	Recv() (*DownloadEvidenceResponse, error)
+	grpc.ClientStream
+}
+
+type complianceServiceDownloadEvidenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *complianceServiceDownloadEvidenceClient) Recv() (*DownloadEvidenceResponse, error) {
+	m := new(DownloadEvidenceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *complianceServiceClient) DeleteEvidence(ctx context.Context, in *DeleteEvidenceRequest, opts ...grpc.CallOption) (*DeleteEvidenceResponse, error) {
+	out := new(DeleteEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/ComplianceService/DeleteEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ComplianceServiceServer is the server API for ComplianceService service.
 // All implementations must embed UnimplementedComplianceServiceServer
 // for forward compatibility
 type ComplianceServiceServer interface {
+	// SetResult persists a result. Results are unique for each subject
+	// and rule within an assessment, so reporting a check again during
+	// the same assessment updates the original result and keeps the
+	// previous values as history.
 	SetResult(context.Context, *ResultRequest) (*ResultResponse, error)
+	// SetResults accepts a stream of results and persists them in
+	// batches. Invalid results are rejected individually without
+	// affecting the rest of the stream. Retried results with an
+	// idempotency key are counted as accepted.
+	SetResults(ComplianceService_SetResultsServer) error
+	GetResult(context.Context, *GetResultRequest) (*Result, error)
+	// ListResults returns results matching all of the provided
+	// filters. Results are returned in pages, use the nextPageToken
+	// from a response to fetch the following page.
+	ListResults(context.Context, *ListResultsRequest) (*ListResultsResponse, error)
+	CreateSubject(context.Context, *CreateSubjectRequest) (*Subject, error)
+	GetSubject(context.Context, *GetSubjectRequest) (*Subject, error)
+	UpdateSubject(context.Context, *UpdateSubjectRequest) (*Subject, error)
+	ListSubjects(context.Context, *ListSubjectsRequest) (*ListSubjectsResponse, error)
+	DeleteSubject(context.Context, *DeleteSubjectRequest) (*DeleteSubjectResponse, error)
+	// ListSubjectDescendants walks the subject hierarchy and returns
+	// every subject below the given subject.
+	ListSubjectDescendants(context.Context, *ListSubjectDescendantsRequest) (*ListSubjectDescendantsResponse, error)
+	// SetSubjectLabels adds labels to a subject, replacing the value of
+	// keys the subject already has.
+	SetSubjectLabels(context.Context, *SetSubjectLabelsRequest) (*Subject, error)
+	// RemoveSubjectLabels removes labels from a subject by key. Keys the
+	// subject doesn't have are ignored.
+	RemoveSubjectLabels(context.Context, *RemoveSubjectLabelsRequest) (*Subject, error)
+	// OpenAssessment starts a new assessment. Results can be added to
+	// the assessment until it's closed.
+	OpenAssessment(context.Context, *OpenAssessmentRequest) (*Assessment, error)
+	GetAssessment(context.Context, *GetAssessmentRequest) (*Assessment, error)
+	ListAssessments(context.Context, *ListAssessmentsRequest) (*ListAssessmentsResponse, error)
+	// AttachResults adds existing results to an open assessment.
+	AttachResults(context.Context, *AttachResultsRequest) (*AttachResultsResponse, error)
+	// CloseAssessment marks an assessment as completed or abandoned.
+	// Closed assessments don't accept new results.
+	CloseAssessment(context.Context, *CloseAssessmentRequest) (*Assessment, error)
+	// ImportCatalog stores an OSCAL catalog and creates a control for
+	// every control and control enhancement in the catalog. Importing
+	// the same catalog version again updates the existing controls.
+	ImportCatalog(context.Context, *ImportCatalogRequest) (*ImportCatalogResponse, error)
+	// ImportProfile stores an OSCAL profile and resolves it against
+	// catalogs that were already imported. Every control the profile
+	// selects is stored as a control of the profile.
+	ImportProfile(context.Context, *ImportProfileRequest) (*ImportProfileResponse, error)
+	// QueryControlPosture returns the subjects with results for a
+	// control, along with the latest result of every rule checked for
+	// that control. Results from abandoned assessments are ignored.
+	QueryControlPosture(context.Context, *QueryControlPostureRequest) (*QueryControlPostureResponse, error)
+	// WatchResults streams results matching a filter as they're
+	// persisted. The first message doesn't include a result and marks
+	// the start of the live feed. Pass the last resume token received
+	// to a new call to replay results missed while disconnected.
+	// Results are sent as their transactions commit, so results written
+	// by transactions that were still running when the token was issued
+	// may be sent again after resuming.
+	WatchResults(*WatchResultsRequest, ComplianceService_WatchResultsServer) error
+	// ExportResults streams the results matching a filter as CSV or
+	// JSON Lines, for loading into spreadsheets and notebooks. Rows are
+	// read from a database cursor and sent in chunks as they're
+	// encoded, so exports don't have to fit in memory. The gateway
+	// would wrap every chunk in a JSON message, so instead of an HTTP
+	// option the export is downloaded from GET /v1/results:export,
+	// which takes the same query parameters as ListResults.
+	ExportResults(*ExportResultsRequest, ComplianceService_ExportResultsServer) error
+	// GetComplianceSummary counts result outcomes, optionally grouped.
+	// Subject groups include the results of every descendant of the
+	// subject. Results from abandoned assessments are ignored.
+	GetComplianceSummary(context.Context, *GetComplianceSummaryRequest) (*GetComplianceSummaryResponse, error)
+	// DiffAssessments compares the results of two assessments, like two
+	// scans of the same cluster. Results are matched by subject, control
+	// and rule.
+	DiffAssessments(context.Context, *DiffAssessmentsRequest) (*DiffAssessmentsResponse, error)
+	// GetComplianceTrend returns the pass rate over time, bucketed by
+	// day or week. Results are bucketed by the start of their
+	// assessment, or when they were reported if they don't belong to an
+	// assessment. Results from abandoned assessments are ignored.
+	GetComplianceTrend(context.Context, *GetComplianceTrendRequest) (*GetComplianceTrendResponse, error)
+	// SetRule creates or updates a rule by name, along with the
+	// controls it provides evidence for.
+	SetRule(context.Context, *SetRuleRequest) (*Rule, error)
+	GetRule(context.Context, *GetRuleRequest) (*Rule, error)
+	// ListRules returns rules in pages, optionally only the ones mapped
+	// to a control.
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	// ImportControlMappings stores a crosswalk between the controls of
+	// two catalogs, like a CIS benchmark and NIST SP 800-53. Importing a
+	// mapping again replaces its relationship.
+	ImportControlMappings(context.Context, *ImportControlMappingsRequest) (*ImportControlMappingsResponse, error)
+	// ListControlMappings returns crosswalk mappings in pages,
+	// optionally only the ones for a control.
+	ListControlMappings(context.Context, *ListControlMappingsRequest) (*ListControlMappingsResponse, error)
+	// ExportAssessmentResults renders an assessment as an OSCAL
+	// assessment results document, so it can be handed to auditors.
+	// Subjects become inventory items, each result an observation, and
+	// each control a finding. Resources keep their IDs as OSCAL UUIDs.
+	ExportAssessmentResults(context.Context, *ExportAssessmentResultsRequest) (*ExportAssessmentResultsResponse, error)
+	// CreateException accepts failures of a control or rule on a subject
+	// and its descendants until the exception expires. Failures it
+	// covers are reported as EXCEPTED by GetComplianceSummary and
+	// QueryControlPosture instead of FAIL.
+	CreateException(context.Context, *CreateExceptionRequest) (*Exception, error)
+	GetException(context.Context, *GetExceptionRequest) (*Exception, error)
+	// ListExceptions returns exceptions in pages, including expired and
+	// revoked ones unless a state is provided.
+	ListExceptions(context.Context, *ListExceptionsRequest) (*ListExceptionsResponse, error)
+	// RevokeException ends an exception before it expires. Revoked
+	// exceptions are kept so there's a record of them.
+	RevokeException(context.Context, *RevokeExceptionRequest) (*Exception, error)
+	// UploadEvidence attaches a file to a result or an assessment, like
+	// the output of a check. The first message describes the evidence
+	// and the contents follow in any number of messages. The contents
+	// are kept in the configured blob store rather than the database.
+	// Over HTTP, POST the contents to /v1/evidence with the fields of
+	// the metadata as query parameters and the Content-Type header.
+	UploadEvidence(ComplianceService_UploadEvidenceServer) error
+	GetEvidence(context.Context, *GetEvidenceRequest) (*Evidence, error)
+	// ListEvidence returns evidence in pages, optionally only the
+	// evidence attached to a result or an assessment.
+	ListEvidence(context.Context, *ListEvidenceRequest) (*ListEvidenceResponse, error)
+	// DownloadEvidence streams the contents of evidence. The first
+	// message describes the evidence. Over HTTP, GET
+	// /v1/evidence/{id}:download returns the contents as a file.
+	DownloadEvidence(*DownloadEvidenceRequest, ComplianceService_DownloadEvidenceServer) error
+	DeleteEvidence(context.Context, *DeleteEvidenceRequest) (*DeleteEvidenceResponse, error)
 	mustEmbedUnimplementedComplianceServiceServer()
 }
 
-// UnimplementedComplianceServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedComplianceServiceServer struct {
+// UnimplementedComplianceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedComplianceServiceServer struct {
+}
+
+func (UnimplementedComplianceServiceServer) SetResult(context.Context, *ResultRequest) (*ResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetResult not implemented")
+}
+func (UnimplementedComplianceServiceServer) SetResults(ComplianceService_SetResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SetResults not implemented")
+}
+func (UnimplementedComplianceServiceServer) GetResult(context.Context, *GetResultRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResult not implemented")
+}
+func (UnimplementedComplianceServiceServer) ListResults(context.Context, *ListResultsRequest) (*ListResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResults not implemented")
+}
+func (UnimplementedComplianceServiceServer) CreateSubject(context.Context, *CreateSubjectRequest) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubject not implemented")
+}
+func (UnimplementedComplianceServiceServer) GetSubject(context.Context, *GetSubjectRequest) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubject not implemented")
+}
+func (UnimplementedComplianceServiceServer) UpdateSubject(context.Context, *UpdateSubjectRequest) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubject not implemented")
+}
+func (UnimplementedComplianceServiceServer) ListSubjects(context.Context, *ListSubjectsRequest) (*ListSubjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubjects not implemented")
+}
+func (UnimplementedComplianceServiceServer) DeleteSubject(context.Context, *DeleteSubjectRequest) (*DeleteSubjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubject not implemented")
+}
+func (UnimplementedComplianceServiceServer) ListSubjectDescendants(context.Context, *ListSubjectDescendantsRequest) (*ListSubjectDescendantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubjectDescendants not implemented")
+}
+func (UnimplementedComplianceServiceServer) SetSubjectLabels(context.Context, *SetSubjectLabelsRequest) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubjectLabels not implemented")
+}
+func (UnimplementedComplianceServiceServer) RemoveSubjectLabels(context.Context, *RemoveSubjectLabelsRequest) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubjectLabels not implemented")
+}
+func (UnimplementedComplianceServiceServer) OpenAssessment(context.Context, *OpenAssessmentRequest) (*Assessment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenAssessment not implemented")
+}
+func (UnimplementedComplianceServiceServer) GetAssessment(context.Context, *GetAssessmentRequest) (*Assessment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessment not implemented")
+}
+func (UnimplementedComplianceServiceServer) ListAssessments(context.Context, *ListAssessmentsRequest) (*ListAssessmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssessments not implemented")
+}
+func (UnimplementedComplianceServiceServer) AttachResults(context.Context, *AttachResultsRequest) (*AttachResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachResults not implemented")
+}
+func (UnimplementedComplianceServiceServer) CloseAssessment(context.Context, *CloseAssessmentRequest) (*Assessment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAssessment not implemented")
+}
+func (UnimplementedComplianceServiceServer) ImportCatalog(context.Context, *ImportCatalogRequest) (*ImportCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCatalog not implemented")
+}
+func (UnimplementedComplianceServiceServer) ImportProfile(context.Context, *ImportProfileRequest) (*ImportProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProfile not implemented")
+}
+func (UnimplementedComplianceServiceServer) QueryControlPosture(context.Context, *QueryControlPostureRequest) (*QueryControlPostureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryControlPosture not implemented")
+}
+func (UnimplementedComplianceServiceServer) WatchResults(*WatchResultsRequest, ComplianceService_WatchResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResults not implemented")
+}
+func (UnimplementedComplianceServiceServer) ExportResults(*ExportResultsRequest, ComplianceService_ExportResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportResults not implemented")
+}
+func (UnimplementedComplianceServiceServer) GetComplianceSummary(context.Context, *GetComplianceSummaryRequest) (*GetComplianceSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComplianceSummary not implemented")
+}
+func (UnimplementedComplianceServiceServer) DiffAssessments(context.Context, *DiffAssessmentsRequest) (*DiffAssessmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffAssessments not implemented")
+}
+func (UnimplementedComplianceServiceServer) GetComplianceTrend(context.Context, *GetComplianceTrendRequest) (*GetComplianceTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComplianceTrend not implemented")
+}
+func (UnimplementedComplianceServiceServer) SetRule(context.Context, *SetRuleRequest) (*Rule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRule not implemented")
+}
+func (UnimplementedComplianceServiceServer) GetRule(context.Context, *GetRuleRequest) (*Rule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRule not implemented")
+}
+func (UnimplementedComplianceServiceServer) ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedComplianceServiceServer) ImportControlMappings(context.Context, *ImportControlMappingsRequest) (*ImportControlMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportControlMappings not implemented")
+}
+func (UnimplementedComplianceServiceServer) ListControlMappings(context.Context, *ListControlMappingsRequest) (*ListControlMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListControlMappings not implemented")
+}
+func (UnimplementedComplianceServiceServer) ExportAssessmentResults(context.Context, *ExportAssessmentResultsRequest) (*ExportAssessmentResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAssessmentResults not implemented")
+}
+func (UnimplementedComplianceServiceServer) CreateException(context.Context, *CreateExceptionRequest) (*Exception, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateException not implemented")
+}
+func (UnimplementedComplianceServiceServer) GetException(context.Context, *GetExceptionRequest) (*Exception, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetException not implemented")
+}
+func (UnimplementedComplianceServiceServer) ListExceptions(context.Context, *ListExceptionsRequest) (*ListExceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExceptions not implemented")
+}
+func (UnimplementedComplianceServiceServer) RevokeException(context.Context, *RevokeExceptionRequest) (*Exception, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeException not implemented")
+}
+func (UnimplementedComplianceServiceServer) UploadEvidence(ComplianceService_UploadEvidenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadEvidence not implemented")
+}
+func (UnimplementedComplianceServiceServer) GetEvidence(context.Context, *GetEvidenceRequest) (*Evidence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvidence not implemented")
+}
+func (UnimplementedComplianceServiceServer) ListEvidence(context.Context, *ListEvidenceRequest) (*ListEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvidence not implemented")
+}
+func (UnimplementedComplianceServiceServer) DownloadEvidence(*DownloadEvidenceRequest, ComplianceService_DownloadEvidenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadEvidence not implemented")
+}
+func (UnimplementedComplianceServiceServer) DeleteEvidence(context.Context, *DeleteEvidenceRequest) (*DeleteEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvidence not implemented")
+}
+func (UnimplementedComplianceServiceServer) mustEmbedUnimplementedComplianceServiceServer() {}
+
+// UnsafeComplianceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ComplianceServiceServer will
+// result in compilation errors.
+type UnsafeComplianceServiceServer interface {
+	mustEmbedUnimplementedComplianceServiceServer()
+}
+
+func RegisterComplianceServiceServer(s grpc.ServiceRegistrar, srv ComplianceServiceServer) {
+	s.RegisterService(&ComplianceService_ServiceDesc, srv)
+}
+
+func _ComplianceService_SetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).SetResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/SetResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).SetResult(ctx, req.(*ResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_SetResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ComplianceServiceServer).SetResults(&complianceServiceSetResultsServer{stream})
+}
+
+type ComplianceService_SetResultsServer interface {
+	SendAndClose(*SetResultsResponse) error
+	Recv() (*ResultRequest, error)
+	grpc.ServerStream
+}
+
+type complianceServiceSetResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *complianceServiceSetResultsServer) SendAndClose(m *SetResultsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *complianceServiceSetResultsServer) Recv() (*ResultRequest, error) {
+	m := new(ResultRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ComplianceService_GetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).GetResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/GetResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).GetResult(ctx, req.(*GetResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_ListResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ListResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ListResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ListResults(ctx, req.(*ListResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_CreateSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).CreateSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/CreateSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).CreateSubject(ctx, req.(*CreateSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_GetSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).GetSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/GetSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).GetSubject(ctx, req.(*GetSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_UpdateSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).UpdateSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/UpdateSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).UpdateSubject(ctx, req.(*UpdateSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_ListSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ListSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ListSubjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ListSubjects(ctx, req.(*ListSubjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_DeleteSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).DeleteSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/DeleteSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).DeleteSubject(ctx, req.(*DeleteSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_ListSubjectDescendants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubjectDescendantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ListSubjectDescendants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ListSubjectDescendants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ListSubjectDescendants(ctx, req.(*ListSubjectDescendantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_SetSubjectLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubjectLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).SetSubjectLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/SetSubjectLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).SetSubjectLabels(ctx, req.(*SetSubjectLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_RemoveSubjectLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSubjectLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).RemoveSubjectLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/RemoveSubjectLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).RemoveSubjectLabels(ctx, req.(*RemoveSubjectLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_OpenAssessment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenAssessmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).OpenAssessment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/OpenAssessment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).OpenAssessment(ctx, req.(*OpenAssessmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_GetAssessment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).GetAssessment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/GetAssessment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).GetAssessment(ctx, req.(*GetAssessmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_ListAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ListAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ListAssessments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ListAssessments(ctx, req.(*ListAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_AttachResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).AttachResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/AttachResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).AttachResults(ctx, req.(*AttachResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_CloseAssessment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAssessmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).CloseAssessment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/CloseAssessment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).CloseAssessment(ctx, req.(*CloseAssessmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_ImportCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ImportCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ImportCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ImportCatalog(ctx, req.(*ImportCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_ImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ImportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ImportProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ImportProfile(ctx, req.(*ImportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_QueryControlPosture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryControlPostureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).QueryControlPosture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/QueryControlPosture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).QueryControlPosture(ctx, req.(*QueryControlPostureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_WatchResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ComplianceServiceServer).WatchResults(m, &complianceServiceWatchResultsServer{stream})
+}
+
+type ComplianceService_WatchResultsServer interface {
+	Send(*WatchResultsResponse) error
+	grpc.ServerStream
+}
+
+type complianceServiceWatchResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *complianceServiceWatchResultsServer) Send(m *WatchResultsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ComplianceService_ExportResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ComplianceServiceServer).ExportResults(m, &complianceServiceExportResultsServer{stream})
+}
+
+type ComplianceService_ExportResultsServer interface {
+	Send(*ExportResultsResponse) error
+	grpc.ServerStream
+}
+
+type complianceServiceExportResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *complianceServiceExportResultsServer) Send(m *ExportResultsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ComplianceService_GetComplianceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComplianceSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).GetComplianceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/GetComplianceSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).GetComplianceSummary(ctx, req.(*GetComplianceSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_DiffAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).DiffAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/DiffAssessments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).DiffAssessments(ctx, req.(*DiffAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_GetComplianceTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComplianceTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).GetComplianceTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/GetComplianceTrend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).GetComplianceTrend(ctx, req.(*GetComplianceTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_SetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).SetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/SetRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).SetRule(ctx, req.(*SetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_GetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).GetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/GetRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).GetRule(ctx, req.(*GetRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedComplianceServiceServer) SetResult(context.Context, *ResultRequest) (*ResultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetResult not implemented")
+func _ComplianceService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ListRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedComplianceServiceServer) mustEmbedUnimplementedComplianceServiceServer() {}
 
-// UnsafeComplianceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ComplianceServiceServer will
-// result in compilation errors.
-type UnsafeComplianceServiceServer interface {
-	mustEmbedUnimplementedComplianceServiceServer()
+func _ComplianceService_ImportControlMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportControlMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ImportControlMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ImportControlMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ImportControlMappings(ctx, req.(*ImportControlMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterComplianceServiceServer(s grpc.ServiceRegistrar, srv ComplianceServiceServer) {
-	s.RegisterService(&ComplianceService_ServiceDesc, srv)
+func _ComplianceService_ListControlMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListControlMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ListControlMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ListControlMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ListControlMappings(ctx, req.(*ListControlMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ComplianceService_SetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResultRequest)
+func _ComplianceService_ExportAssessmentResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAssessmentResultsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ComplianceServiceServer).SetResult(ctx, in)
+		return srv.(ComplianceServiceServer).ExportAssessmentResults(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ComplianceService/SetResult",
+		FullMethod: "/ComplianceService/ExportAssessmentResults",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ComplianceServiceServer).SetResult(ctx, req.(*ResultRequest))
+		return srv.(ComplianceServiceServer).ExportAssessmentResults(ctx, req.(*ExportAssessmentResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_CreateException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).CreateException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/CreateException",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).CreateException(ctx, req.(*CreateExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_GetException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).GetException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/GetException",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).GetException(ctx, req.(*GetExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_ListExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ListExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ListExceptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ListExceptions(ctx, req.(*ListExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_RevokeException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).RevokeException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/RevokeException",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).RevokeException(ctx, req.(*RevokeExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_UploadEvidence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ComplianceServiceServer).UploadEvidence(&complianceServiceUploadEvidenceServer{stream})
+}
+
+type ComplianceService_UploadEvidenceServer interface {
+	SendAndClose(*Evidence) error
+	Recv() (*UploadEvidenceRequest, error)
+	grpc.ServerStream
+}
+
+type complianceServiceUploadEvidenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *complianceServiceUploadEvidenceServer) SendAndClose(m *Evidence) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *complianceServiceUploadEvidenceServer) Recv() (*UploadEvidenceRequest, error) {
+	m := new(UploadEvidenceRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ComplianceService_GetEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).GetEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/GetEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).GetEvidence(ctx, req.(*GetEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_ListEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).ListEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/ListEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).ListEvidence(ctx, req.(*ListEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComplianceService_DownloadEvidence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadEvidenceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ComplianceServiceServer).DownloadEvidence(m, &complianceServiceDownloadEvidenceServer{stream})
+}
+
+type ComplianceService_DownloadEvidenceServer interface {
+	Send(*DownloadEvidenceResponse) error
+	grpc.ServerStream
+}
+
+type complianceServiceDownloadEvidenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *complianceServiceDownloadEvidenceServer) Send(m *DownloadEvidenceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ComplianceService_DeleteEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplianceServiceServer).DeleteEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ComplianceService/DeleteEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplianceServiceServer).DeleteEvidence(ctx, req.(*DeleteEvidenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -99,7 +1665,169 @@ var ComplianceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetResult",
 			Handler:    _ComplianceService_SetResult_Handler,
 		},
+		{
+			MethodName: "GetResult",
+			Handler:    _ComplianceService_GetResult_Handler,
+		},
+		{
+			MethodName: "ListResults",
+			Handler:    _ComplianceService_ListResults_Handler,
+		},
+		{
+			MethodName: "CreateSubject",
+			Handler:    _ComplianceService_CreateSubject_Handler,
+		},
+		{
+			MethodName: "GetSubject",
+			Handler:    _ComplianceService_GetSubject_Handler,
+		},
+		{
+			MethodName: "UpdateSubject",
+			Handler:    _ComplianceService_UpdateSubject_Handler,
+		},
+		{
+			MethodName: "ListSubjects",
+			Handler:    _ComplianceService_ListSubjects_Handler,
+		},
+		{
+			MethodName: "DeleteSubject",
+			Handler:    _ComplianceService_DeleteSubject_Handler,
+		},
+		{
+			MethodName: "ListSubjectDescendants",
+			Handler:    _ComplianceService_ListSubjectDescendants_Handler,
+		},
+		{
+			MethodName: "SetSubjectLabels",
+			Handler:    _ComplianceService_SetSubjectLabels_Handler,
+		},
+		{
+			MethodName: "RemoveSubjectLabels",
+			Handler:    _ComplianceService_RemoveSubjectLabels_Handler,
+		},
+		{
+			MethodName: "OpenAssessment",
+			Handler:    _ComplianceService_OpenAssessment_Handler,
+		},
+		{
+			MethodName: "GetAssessment",
+			Handler:    _ComplianceService_GetAssessment_Handler,
+		},
+		{
+			MethodName: "ListAssessments",
+			Handler:    _ComplianceService_ListAssessments_Handler,
+		},
+		{
+			MethodName: "AttachResults",
+			Handler:    _ComplianceService_AttachResults_Handler,
+		},
+		{
+			MethodName: "CloseAssessment",
+			Handler:    _ComplianceService_CloseAssessment_Handler,
+		},
+		{
+			MethodName: "ImportCatalog",
+			Handler:    _ComplianceService_ImportCatalog_Handler,
+		},
+		{
+			MethodName: "ImportProfile",
+			Handler:    _ComplianceService_ImportProfile_Handler,
+		},
+		{
+			MethodName: "QueryControlPosture",
+			Handler:    _ComplianceService_QueryControlPosture_Handler,
+		},
+		{
+			MethodName: "GetComplianceSummary",
+			Handler:    _ComplianceService_GetComplianceSummary_Handler,
+		},
+		{
+			MethodName: "DiffAssessments",
+			Handler:    _ComplianceService_DiffAssessments_Handler,
+		},
+		{
+			MethodName: "GetComplianceTrend",
+			Handler:    _ComplianceService_GetComplianceTrend_Handler,
+		},
+		{
+			MethodName: "SetRule",
+			Handler:    _ComplianceService_SetRule_Handler,
+		},
+		{
+			MethodName: "GetRule",
+			Handler:    _ComplianceService_GetRule_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _ComplianceService_ListRules_Handler,
+		},
+		{
+			MethodName: "ImportControlMappings",
+			Handler:    _ComplianceService_ImportControlMappings_Handler,
+		},
+		{
+			MethodName: "ListControlMappings",
+			Handler:    _ComplianceService_ListControlMappings_Handler,
+		},
+		{
+			MethodName: "ExportAssessmentResults",
+			Handler:    _ComplianceService_ExportAssessmentResults_Handler,
+		},
+		{
+			MethodName: "CreateException",
+			Handler:    _ComplianceService_CreateException_Handler,
+		},
+		{
+			MethodName: "GetException",
+			Handler:    _ComplianceService_GetException_Handler,
+		},
+		{
+			MethodName: "ListExceptions",
+			Handler:    _ComplianceService_ListExceptions_Handler,
+		},
+		{
+			MethodName: "RevokeException",
+			Handler:    _ComplianceService_RevokeException_Handler,
+		},
+		{
+			MethodName: "GetEvidence",
+			Handler:    _ComplianceService_GetEvidence_Handler,
+		},
+		{
+			MethodName: "ListEvidence",
+			Handler:    _ComplianceService_ListEvidence_Handler,
+		},
+		{
+			MethodName: "DeleteEvidence",
+			Handler:    _ComplianceService_DeleteEvidence_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SetResults",
+			Handler:       _ComplianceService_SetResults_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchResults",
+			Handler:       _ComplianceService_WatchResults_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportResults",
+			Handler:       _ComplianceService_ExportResults_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadEvidence",
+			Handler:       _ComplianceService_UploadEvidence_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadEvidence",
+			Handler:       _ComplianceService_DownloadEvidence_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/compserv.proto",
 }
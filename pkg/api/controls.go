@@ -0,0 +1,173 @@
+package compserv
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	oscal "github.com/rhmdnd/compserv/pkg/oscal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// controlImportColumns are the columns of catalog and profile controls updated
+// when they're imported again.
+var controlImportColumns = []string{"name", "title", "parent_id", "family_id", "position"}
+
+// upsertControlFamilies creates a family for every group of the catalog
+// that contains controls, or updates it if it was created by a previous
+// import. It returns the family IDs keyed by group ID. The controls must be
+// every control of the catalog, so families are ordered by the first
+// control they contain.
+func upsertControlFamilies(tx *gorm.DB, catalogID string, controls []oscal.FlatControl) (map[string]string, error) {
+	var rows []models.ControlFamily
+	seen := map[string]bool{}
+	for _, ctl := range controls {
+		if ctl.GroupID == "" || seen[ctl.GroupID] {
+			continue
+		}
+		seen[ctl.GroupID] = true
+		rows = append(rows, models.ControlFamily{
+			ID:        uuid.NewString(),
+			CatalogID: catalogID,
+			OscalID:   ctl.GroupID,
+			Title:     toNullString(ctl.GroupTitle),
+			Position:  ctl.Position,
+		})
+	}
+	families := map[string]string{}
+	if len(rows) == 0 {
+		return families, nil
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "catalog_id"}, {Name: "oscal_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "position"}),
+	}).Create(rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create control families for catalog %s: %w", catalogID, err)
+	}
+
+	var stored []models.ControlFamily
+	if err := tx.Select("id", "oscal_id").Where("catalog_id = ?", catalogID).Find(&stored).Error; err != nil {
+		return nil, fmt.Errorf("failed to lookup control families for catalog %s: %w", catalogID, err)
+	}
+	for _, f := range stored {
+		families[f.OscalID] = f.ID
+	}
+	return families, nil
+}
+
+// controlIDs returns the IDs of the controls selected by a query keyed by
+// OSCAL ID.
+func controlIDs(q *gorm.DB) (map[string]string, error) {
+	var controls []models.Control
+	if err := q.Select("id", "oscal_id").Find(&controls).Error; err != nil {
+		return nil, fmt.Errorf("failed to lookup existing controls: %w", err)
+	}
+	ids := make(map[string]string, len(controls))
+	for _, c := range controls {
+		ids[c.OscalID.String] = c.ID
+	}
+	return ids, nil
+}
+
+// controlRows builds the rows for catalog controls, along with their place
+// in the catalog. Controls that were already stored keep their IDs so
+// enhancements can reference their parent before the rows are written.
+// Enhancements of controls that aren't included don't reference a parent.
+func controlRows(base models.Control, controls []oscal.FlatControl,
+	existing, families map[string]string,
+) []models.Control {
+	ids := make(map[string]string, len(controls))
+	for _, ctl := range controls {
+		id, ok := existing[ctl.ID]
+		if !ok {
+			id = uuid.NewString()
+		}
+		ids[ctl.ID] = id
+	}
+	rows := make([]models.Control, 0, len(controls))
+	for _, ctl := range controls {
+		row := base
+		row.ID = ids[ctl.ID]
+		row.Name = ctl.Label
+		row.OscalID = toNullString(ctl.ID)
+		row.Title = toNullString(ctl.Title)
+		row.ParentID = toNullString(ids[ctl.ParentID])
+		row.FamilyID = toNullString(families[ctl.GroupID])
+		row.Position = sql.NullInt32{Int32: int32(ctl.Position), Valid: true}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// matchingControls selects the IDs of the controls matching a name or OSCAL
+// ID, along with their enhancements. Catalogs can use the same labels, like
+// 1.1, so the controls can be limited to a single catalog.
+func matchingControls(db *gorm.DB, control, catalogID string) *gorm.DB {
+	q := db.Table("controls AS named").Select("named.id").
+		Joins("LEFT JOIN controls AS parents ON parents.id = named.parent_id").
+		Where("named.name = ? OR named.oscal_id = ? OR parents.name = ? OR parents.oscal_id = ?",
+			control, control, control, control)
+	if catalogID != "" {
+		q = q.Where("named.catalog_id = ?", catalogID)
+	}
+	return q
+}
+
+// familyControls selects the IDs of the controls in families matching an
+// OSCAL ID, like ac, or a title, like Access Control, optionally limited to
+// a single catalog.
+func familyControls(db *gorm.DB, family, catalogID string) *gorm.DB {
+	q := db.Table("controls AS named").Select("named.id").
+		Joins("JOIN control_families ON control_families.id = named.family_id").
+		Where("control_families.oscal_id = ? OR control_families.title = ?", family, family)
+	if catalogID != "" {
+		q = q.Where("named.catalog_id = ?", catalogID)
+	}
+	return q
+}
+
+// controlNames selects the names of the controls with the given IDs. Results
+// are matched to controls by name, since they reference the control they
+// were reported for rather than a catalog control.
+func controlNames(db, ids *gorm.DB) *gorm.DB {
+	return db.Table("controls AS named").Select("named.name").Where("named.id IN (?)", ids)
+}
+
+// controlResultsCondition returns a condition matching the results that
+// count towards the controls with the given IDs. That's results reported for
+// them, results of rules mapped to them and, if any relationships are given,
+// results for the controls they're crosswalked to. Rules and crosswalks are
+// followed from the controls themselves rather than their names, so controls
+// of other catalogs with the same label don't contribute their mappings. The
+// query must join the controls of the results.
+func controlResultsCondition(db, ids *gorm.DB, relationships []string) (string, []interface{}) {
+	match := "controls.name IN (?) OR results.rule_id IN (?)"
+	args := []interface{}{controlNames(db, ids), mappedRules(db, "mapped.id IN (?)", ids)}
+	if len(relationships) > 0 {
+		crosswalked := crosswalkedControls(db, ids, relationships)
+		match += " OR controls.name IN (?) OR results.rule_id IN (?)"
+		args = append(args, controlNames(db, crosswalked), mappedRules(db, "mapped.id IN (?)", crosswalked))
+	}
+	return match, args
+}
+
+// controlHierarchyJoin joins the base control and family of the control each
+// result was reported for, so enhancements roll up into the control they
+// enhance. Like results are matched to controls, the hierarchy is looked up
+// by name, preferring catalog controls that have a family. The query must
+// join the controls of the results.
+const controlHierarchyJoin = `LEFT JOIN LATERAL (
+	SELECT COALESCE(parents.name, named.name) AS control,
+		COALESCE(parents.position, named.position) AS control_position,
+		control_families.id AS family_id, control_families.title AS family,
+		control_families.position AS family_position
+	FROM controls AS named
+	LEFT JOIN controls AS parents ON parents.id = named.parent_id
+	LEFT JOIN control_families ON control_families.id = named.family_id
+	WHERE named.name = controls.name
+	ORDER BY named.family_id IS NULL, named.profile_id IS NOT NULL, named.id
+	LIMIT 1
+) AS hierarchy ON true`
@@ -0,0 +1,322 @@
+package compserv
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	oscal "github.com/rhmdnd/compserv/pkg/oscal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// These are the values stored in the control_mappings.relationship column.
+const (
+	mappingEquivalent = "EQUIVALENT"
+	mappingSubset     = "SUBSET"
+	mappingSuperset   = "SUPERSET"
+	mappingIntersects = "INTERSECTS"
+)
+
+var mappingRelationships = map[string]MappingRelationship{
+	mappingEquivalent: MappingRelationship_MAPPING_RELATIONSHIP_EQUIVALENT,
+	mappingSubset:     MappingRelationship_MAPPING_RELATIONSHIP_SUBSET,
+	mappingSuperset:   MappingRelationship_MAPPING_RELATIONSHIP_SUPERSET,
+	mappingIntersects: MappingRelationship_MAPPING_RELATIONSHIP_INTERSECTS,
+}
+
+// oscalRelationships maps the OSCAL relationship vocabulary, which is also
+// used by CSV documents, to the stored values.
+var oscalRelationships = map[string]string{
+	oscal.RelationshipEquivalentTo:   mappingEquivalent,
+	oscal.RelationshipEqualTo:        mappingEquivalent,
+	oscal.RelationshipSubsetOf:       mappingSubset,
+	oscal.RelationshipSupersetOf:     mappingSuperset,
+	oscal.RelationshipIntersectsWith: mappingIntersects,
+}
+
+// crosswalkRelationships are the relationships followed by each crosswalk
+// mode.
+var crosswalkRelationships = map[Crosswalk][]string{
+	Crosswalk_CROSSWALK_EQUIVALENT: {mappingEquivalent},
+	Crosswalk_CROSSWALK_PARTIAL:    {mappingEquivalent, mappingSubset, mappingSuperset, mappingIntersects},
+}
+
+// crosswalkedControls selects the IDs of the controls mapped to the controls
+// with the given IDs with one of the relationships. Mappings are followed in
+// both directions, since results for either control are evidence for the
+// other.
+func crosswalkedControls(db, ids *gorm.DB, relationships []string) *gorm.DB {
+	return db.Table("control_mappings").Select("crosswalked.id").
+		Joins("JOIN controls AS matched ON matched.id IN "+
+			"(control_mappings.source_control_id, control_mappings.target_control_id)").
+		Joins("JOIN controls AS crosswalked ON crosswalked.id IN "+
+			"(control_mappings.source_control_id, control_mappings.target_control_id) AND crosswalked.id <> matched.id").
+		Where("matched.id IN (?)", ids).
+		Where("control_mappings.relationship IN ?", relationships)
+}
+
+// parseMappingCSV reads a crosswalk in CSV format. The header row names the
+// source, target and relationship columns, and any other columns, like
+// notes, are ignored.
+func parseMappingCSV(content []byte) ([]oscal.FlatMapping, error) {
+	r := csv.NewReader(bytes.NewReader(content))
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"source", "target", "relationship"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("CSV header is missing the %s column", name)
+		}
+	}
+
+	var mappings []oscal.FlatMapping
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return mappings, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+		m := oscal.FlatMapping{
+			Source:       strings.TrimSpace(record[columns["source"]]),
+			Target:       strings.TrimSpace(record[columns["target"]]),
+			Relationship: strings.ToLower(strings.TrimSpace(record[columns["relationship"]])),
+		}
+		if m.Source == "" || m.Target == "" {
+			return nil, fmt.Errorf("line %d is missing a source or target control", line)
+		}
+		if m.Relationship == oscal.RelationshipNone {
+			continue
+		}
+		if _, ok := oscalRelationships[m.Relationship]; !ok {
+			return nil, fmt.Errorf("line %d has unknown relationship %q", line, m.Relationship)
+		}
+		mappings = append(mappings, m)
+	}
+}
+
+// mappedControlResolver looks up the controls referenced by a crosswalk. It
+// caches the IDs it finds since crosswalks reference the same controls many
+// times.
+type mappedControlResolver struct {
+	tx       *gorm.DB
+	controls map[[2]string]string
+}
+
+// resolve returns the ID of a control. Controls of a catalog are matched by
+// OSCAL ID or name. Without a catalog, the control is looked up by name and
+// created if it doesn't exist.
+func (r *mappedControlResolver) resolve(catalogID, control string) (string, error) {
+	if id, ok := r.controls[[2]string{catalogID, control}]; ok {
+		return id, nil
+	}
+	if len(control) > maxNameLength {
+		return "", invalidField("content", "control must be %d characters or less", maxNameLength)
+	}
+	var id string
+	if catalogID == "" {
+		var err error
+		if id, err = findOrCreateControl(r.tx, control, ""); err != nil {
+			return "", err
+		}
+	} else {
+		c := models.Control{}
+		// Prefer the OSCAL ID in case a label matches the ID of another
+		// control.
+		err := r.tx.Where("catalog_id = ? AND profile_id IS NULL AND oscal_id = ?", catalogID, control).Take(&c).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = r.tx.Where("catalog_id = ? AND profile_id IS NULL AND name = ?", catalogID, control).Take(&c).Error
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", invalidField("content", "control %s does not exist in catalog %s", control, catalogID)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to lookup control %s in catalog %s: %w", control, catalogID, err)
+		}
+		id = c.ID
+	}
+	r.controls[[2]string{catalogID, control}] = id
+	return id, nil
+}
+
+// mappingCatalogIDs returns the catalogs the source and target controls of
+// each mapping belong to. CSV documents name the catalogs in the request,
+// while OSCAL mapping collections reference them by href.
+func mappingCatalogIDs(request *ImportControlMappingsRequest, mappings []oscal.FlatMapping) ([][2]string, error) {
+	catalogs := map[string]string{
+		"sourceCatalogId": request.GetSourceCatalogId(),
+		"targetCatalogId": request.GetTargetCatalogId(),
+	}
+	for field, id := range catalogs {
+		if _, err := uuid.Parse(id); id != "" && err != nil {
+			return nil, invalidField(field, "catalog ID %q is not a valid UUID", id)
+		}
+	}
+	for href, id := range request.GetCatalogIds() {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, invalidField("catalogIds", "catalog ID %q for %s is not a valid UUID", id, href)
+		}
+	}
+	ids := make([][2]string, 0, len(mappings))
+	for _, m := range mappings {
+		if request.GetFormat() == MappingFormat_MAPPING_FORMAT_CSV {
+			ids = append(ids, [2]string{request.GetSourceCatalogId(), request.GetTargetCatalogId()})
+			continue
+		}
+		var pair [2]string
+		for i, href := range []string{m.SourceHref, m.TargetHref} {
+			id, ok := request.GetCatalogIds()[href]
+			if !ok {
+				return nil, invalidField("catalogIds", "no catalog ID provided for resource %s", href)
+			}
+			pair[i] = id
+		}
+		ids = append(ids, pair)
+	}
+	return ids, nil
+}
+
+func (s *server) ImportControlMappings(ctx context.Context,
+	request *ImportControlMappingsRequest,
+) (*ImportControlMappingsResponse, error) {
+	var mappings []oscal.FlatMapping
+	switch request.GetFormat() {
+	case MappingFormat_MAPPING_FORMAT_CSV:
+		var err error
+		if mappings, err = parseMappingCSV(request.GetContent()); err != nil {
+			return nil, invalidField("content", "%s", err)
+		}
+	case MappingFormat_MAPPING_FORMAT_OSCAL:
+		mc, err := oscal.ParseMappingCollection(request.GetContent())
+		if err != nil {
+			return nil, invalidField("content", "%s", err)
+		}
+		mappings = mc.Flatten()
+	default:
+		return nil, invalidField("format", "unknown mapping format %d", request.GetFormat())
+	}
+	catalogIDs, err := mappingCatalogIDs(request, mappings)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	err = s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		r := &mappedControlResolver{tx: tx, controls: map[[2]string]string{}}
+		// A pair mapped more than once keeps the last relationship, since
+		// a statement can't update the same row twice.
+		rows := map[[2]string]*models.ControlMapping{}
+		var order [][2]string
+		for i := range mappings {
+			m := &mappings[i]
+			sourceID, err := r.resolve(catalogIDs[i][0], m.Source)
+			if err != nil {
+				return err
+			}
+			targetID, err := r.resolve(catalogIDs[i][1], m.Target)
+			if err != nil {
+				return err
+			}
+			if sourceID == targetID {
+				return invalidField("content", "control %s can't be mapped to itself", m.Source)
+			}
+			pair := [2]string{sourceID, targetID}
+			if _, ok := rows[pair]; !ok {
+				order = append(order, pair)
+			}
+			rows[pair] = &models.ControlMapping{
+				ID:              uuid.NewString(),
+				SourceControlID: sourceID,
+				TargetControlID: targetID,
+				Relationship:    oscalRelationships[m.Relationship],
+			}
+		}
+		if len(order) == 0 {
+			return nil
+		}
+		batch := make([]*models.ControlMapping, 0, len(order))
+		for _, pair := range order {
+			batch = append(batch, rows[pair])
+		}
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "source_control_id"}, {Name: "target_control_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"relationship"}),
+		}).CreateInBatches(batch, controlBatchSize).Error
+		if err != nil {
+			return fmt.Errorf("failed to create control mappings: %w", err)
+		}
+		count = int64(len(batch))
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &ImportControlMappingsResponse{Mappings: count}, nil
+}
+
+// controlMappingRow is a mapping along with the names of its controls.
+type controlMappingRow struct {
+	models.ControlMapping
+	SourceControl string
+	TargetControl string
+}
+
+func (s *server) ListControlMappings(ctx context.Context,
+	request *ListControlMappingsRequest,
+) (*ListControlMappingsResponse, error) {
+	if err := checkLength("control", request.GetControl(), maxNameLength); err != nil {
+		return nil, err
+	}
+	after, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	size := getPageSize(request.GetPageSize())
+
+	q := s.database.WithContext(ctx).Table("control_mappings").
+		Select("control_mappings.*, sources.name AS source_control, targets.name AS target_control").
+		Joins("JOIN controls AS sources ON sources.id = control_mappings.source_control_id").
+		Joins("JOIN controls AS targets ON targets.id = control_mappings.target_control_id")
+	if c := request.GetControl(); c != "" {
+		q = q.Where("sources.name = ? OR sources.oscal_id = ? OR targets.name = ? OR targets.oscal_id = ?", c, c, c, c)
+	}
+	if after != "" {
+		q = q.Where("control_mappings.id > ?", after)
+	}
+	var rows []controlMappingRow
+	if err := q.Order("control_mappings.id").Limit(size + 1).Scan(&rows).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &ListControlMappingsResponse{}
+	if len(rows) > size {
+		rows = rows[:size]
+		response.NextPageToken = encodePageToken(rows[size-1].ID)
+	}
+	for _, row := range rows {
+		response.Mappings = append(response.Mappings, &ControlMapping{
+			Id:              row.ID,
+			SourceControlId: row.SourceControlID,
+			SourceControl:   row.SourceControl,
+			TargetControlId: row.TargetControlID,
+			TargetControl:   row.TargetControl,
+			Relationship:    mappingRelationships[row.Relationship],
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,129 @@
+package compserv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// resultKey identifies the same check across assessments. Controls are
+// matched by name since results for the same control may reference
+// different control rows.
+type resultKey struct {
+	subjectID string
+	control   string
+	rule      string
+}
+
+// latestAssessmentResults returns the latest result of each subject, control
+// and rule in an assessment, keyed for comparison.
+func latestAssessmentResults(db *gorm.DB, assessmentID string) (map[resultKey]*resultRow, error) {
+	var rows []resultRow
+	q := selectResults(db).
+		Select("DISTINCT ON (results.subject_id, controls.name, results.name) "+resultColumns).
+		Where("results.assessment_id = ?", assessmentID).
+		Order("results.subject_id, controls.name, results.name, metadata.created_at DESC NULLS LAST, results.id DESC")
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to lookup results of assessment %s: %w", assessmentID, err)
+	}
+	latest := make(map[resultKey]*resultRow, len(rows))
+	for i := range rows {
+		k := resultKey{subjectID: rows[i].SubjectID.String, control: rows[i].Control.String, rule: rows[i].Name}
+		latest[k] = &rows[i]
+	}
+	return latest, nil
+}
+
+func newResultDiff(base, target *resultRow) *ResultDiff {
+	row := target
+	if row == nil {
+		row = base
+	}
+	d := &ResultDiff{
+		SubjectId: row.SubjectID.String,
+		Subject:   row.Subject.String,
+		Control:   row.Control.String,
+		Rule:      row.Name,
+	}
+	if base != nil {
+		d.Base = toResultMessage(base)
+	}
+	if target != nil {
+		d.Target = toResultMessage(target)
+	}
+	return d
+}
+
+func sortResultDiffs(diffs []*ResultDiff) {
+	sort.Slice(diffs, func(i, j int) bool {
+		a, b := diffs[i], diffs[j]
+		if a.Subject != b.Subject {
+			return a.Subject < b.Subject
+		}
+		if a.Control != b.Control {
+			return a.Control < b.Control
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		return a.SubjectId < b.SubjectId
+	})
+}
+
+func (s *server) DiffAssessments(ctx context.Context,
+	request *DiffAssessmentsRequest,
+) (*DiffAssessmentsResponse, error) {
+	if err := checkUUID("baseAssessmentId", request.GetBaseAssessmentId()); err != nil {
+		return nil, err
+	}
+	if err := checkUUID("targetAssessmentId", request.GetTargetAssessmentId()); err != nil {
+		return nil, err
+	}
+
+	db := s.database.WithContext(ctx)
+	latest := make([]map[resultKey]*resultRow, 0, 2)
+	for _, id := range []string{request.GetBaseAssessmentId(), request.GetTargetAssessmentId()} {
+		if _, err := getAssessment(db, id); err != nil {
+			return nil, toStatusError(err)
+		}
+		results, err := latestAssessmentResults(db, id)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		latest = append(latest, results)
+	}
+	base, target := latest[0], latest[1]
+
+	response := &DiffAssessmentsResponse{}
+	for k, b := range base {
+		t, ok := target[k]
+		if !ok {
+			response.Removed = append(response.Removed, newResultDiff(b, nil))
+			continue
+		}
+		before, after := classifyOutcome(b.Outcome), classifyOutcome(t.Outcome)
+		switch {
+		case b.Outcome == t.Outcome:
+			response.Unchanged++
+		case before == outcomePassed && (after == outcomeFailed || after == outcomeErrored):
+			response.Regressed = append(response.Regressed, newResultDiff(b, t))
+		case (before == outcomeFailed || before == outcomeErrored) && after == outcomePassed:
+			response.Fixed = append(response.Fixed, newResultDiff(b, t))
+		default:
+			response.Changed = append(response.Changed, newResultDiff(b, t))
+		}
+	}
+	for k, t := range target {
+		if _, ok := base[k]; !ok {
+			response.Added = append(response.Added, newResultDiff(nil, t))
+		}
+	}
+	for _, diffs := range [][]*ResultDiff{
+		response.Added, response.Removed, response.Regressed, response.Fixed, response.Changed,
+	} {
+		sortResultDiffs(diffs)
+	}
+	return response, nil
+}
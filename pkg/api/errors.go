@@ -0,0 +1,188 @@
+package compserv
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"gorm.io/gorm"
+)
+
+// Every RPC reports errors as a gRPC status. Problems with a request are
+// InvalidArgument errors, and the ones caused by a specific field include a
+// google.rpc.BadRequest detail naming it, so clients know which field to
+// fix. Database errors are translated by toStatusError.
+
+// invalidField returns an InvalidArgument error with a BadRequest detail
+// for a field of the request. Fields of nested messages are separated by
+// dots, like filter.subjectId.
+func invalidField(field, format string, args ...interface{}) error {
+	st := status.Newf(codes.InvalidArgument, format, args...)
+	return withDetails(st, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: st.Message()}},
+	})
+}
+
+// requiredField returns the error for a field the request must include.
+func requiredField(field string) error {
+	return invalidField(field, "%s is required", field)
+}
+
+// checkRequired makes sure the request includes a field.
+func checkRequired(field, value string) error {
+	if value == "" {
+		return requiredField(field)
+	}
+	return nil
+}
+
+// checkLength makes sure a field fits the column it's stored in.
+func checkLength(field, value string, max int) error {
+	if len(value) > max {
+		return invalidField(field, "%s must be %d characters or less", field, max)
+	}
+	return nil
+}
+
+// checkUUID makes sure a field contains a UUID.
+func checkUUID(field, value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return invalidField(field, "%s %q is not a valid UUID", field, value)
+	}
+	return nil
+}
+
+// firstError returns the first of the errors that isn't nil, so a request
+// can be checked with a list of validations.
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// violationField returns the field named by the BadRequest detail of a
+// status, if it has one.
+func violationField(st *status.Status) string {
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok && len(br.GetFieldViolations()) > 0 {
+			return br.GetFieldViolations()[0].GetField()
+		}
+	}
+	return ""
+}
+
+// withDetails attaches details to a status, falling back to the status
+// alone if they can't be encoded.
+func withDetails(st *status.Status, details ...protoiface.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// sqlStateErrors translates PostgreSQL errors into gRPC statuses by their
+// SQLSTATE, see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+// Errors that aren't listed are internal errors.
+var sqlStateErrors = map[string]func(*pgconn.PgError) error{
+	// foreign_key_violation
+	"23503": func(e *pgconn.PgError) error {
+		// PostgreSQL reports the referencing table for both sides of
+		// the constraint, so only the message tells a missing reference
+		// apart from deleting a row that is still referenced.
+		if strings.HasPrefix(e.Message, "update or delete") {
+			return status.Errorf(codes.FailedPrecondition, "the resource is still referenced by %s", e.TableName)
+		}
+		field := constraintField(e, "fk_")
+		return invalidField(field, "%s references a resource that does not exist", field)
+	},
+	// not_null_violation
+	"23502": func(e *pgconn.PgError) error {
+		return requiredField(columnField(e.ColumnName))
+	},
+	// check_violation
+	"23514": func(e *pgconn.PgError) error {
+		field := constraintField(e, "chk_")
+		return invalidField(field, "%s is not a supported value", field)
+	},
+	// unique_violation
+	"23505": func(e *pgconn.PgError) error {
+		st := status.Newf(codes.AlreadyExists, "the resource already exists in %s", e.TableName)
+		return withDetails(st, &errdetails.ResourceInfo{ResourceType: e.TableName, Description: st.Message()})
+	},
+	// string_data_right_truncation, the value doesn't fit a VARCHAR
+	// column. PostgreSQL doesn't say which one.
+	"22001": func(e *pgconn.PgError) error {
+		return status.Error(codes.InvalidArgument, "a value is longer than the field allows")
+	},
+	// invalid_text_representation, like a malformed UUID
+	"22P02": func(e *pgconn.PgError) error {
+		return status.Error(codes.InvalidArgument, "a value is not formatted correctly for its field")
+	},
+	// serialization_failure and deadlock_detected are safe to retry
+	"40001": func(e *pgconn.PgError) error {
+		return status.Error(codes.Aborted, "concurrent update, retry the request")
+	},
+	"40P01": func(e *pgconn.PgError) error {
+		return status.Error(codes.Aborted, "concurrent update, retry the request")
+	},
+	// query_canceled
+	"57014": func(e *pgconn.PgError) error {
+		return status.Error(codes.Canceled, "request canceled")
+	},
+}
+
+// constraintField names the request field for the column of a constraint.
+// Constraints are named after their table and column, like
+// fk_subjects_parent_id, which is the parentId field.
+func constraintField(e *pgconn.PgError, prefix string) string {
+	column := strings.TrimPrefix(e.ConstraintName, prefix+e.TableName+"_")
+	return columnField(column)
+}
+
+// columnField converts a column name to the name of the field in the API,
+// like parent_id to parentId.
+func columnField(column string) string {
+	parts := strings.Split(column, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// toStatusError passes along errors that already carry a gRPC status and
+// translates database errors clients can act on. Everything else is hidden
+// behind a generic internal error so we don't leak database details to
+// clients.
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.As(err, &pgErr):
+		if translate, ok := sqlStateErrors[pgErr.Code]; ok {
+			return translate(pgErr)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "not found")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "request deadline exceeded")
+	}
+	log.Printf("Unexpected error handling request: %s", err)
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,77 @@
+package compserv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInvalidFieldIncludesViolation(t *testing.T) {
+	t.Parallel()
+	err := checkLength("subject", string(make([]byte, maxNameLength+1)), maxNameLength)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code(), "expected %s got %s", codes.InvalidArgument, st.Code())
+	assert.Equal(t, "subject must be 255 characters or less", st.Message())
+	assert.Equal(t, "subject", violationField(st), "expected %s got %s", "subject", violationField(st))
+
+	// Validations are checked in order
+	st = status.Convert(firstError(checkRequired("subject", "cluster"), checkUUID("id", ""), checkRequired("rule", "")))
+	assert.Equal(t, "id", violationField(st), "expected %s got %s", "id", violationField(st))
+	assert.Nil(t, checkUUID("id", "8c3c8c6e-1b7b-4d1e-9a8e-6f5d2c1b0a9f"))
+}
+
+func TestToStatusErrorTranslatesDatabaseErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		err   error
+		code  codes.Code
+		field string
+	}{
+		{
+			&pgconn.PgError{
+				Code: "23503", TableName: "subjects", ConstraintName: "fk_subjects_parent_id",
+				Message: `insert or update on table "subjects" violates foreign key constraint "fk_subjects_parent_id"`,
+			},
+			codes.InvalidArgument, "parentId",
+		},
+		{
+			&pgconn.PgError{
+				Code: "23503", TableName: "results", ConstraintName: "fk_results_subject_id",
+				Message: `update or delete on table "subjects" violates foreign key constraint "fk_results_subject_id"`,
+			},
+			codes.FailedPrecondition, "",
+		},
+		{&pgconn.PgError{Code: "23502", TableName: "rules", ColumnName: "name"}, codes.InvalidArgument, "name"},
+		{
+			&pgconn.PgError{Code: "23514", TableName: "assessments", ConstraintName: "chk_assessments_state"},
+			codes.InvalidArgument, "state",
+		},
+		{&pgconn.PgError{Code: "23505", TableName: "rules"}, codes.AlreadyExists, ""},
+		{&pgconn.PgError{Code: "22001"}, codes.InvalidArgument, ""},
+		{&pgconn.PgError{Code: "22P02"}, codes.InvalidArgument, ""},
+		{&pgconn.PgError{Code: "40001"}, codes.Aborted, ""},
+		{&pgconn.PgError{Code: "53300"}, codes.Internal, ""},
+		{fmt.Errorf("failed to save results: %w", &pgconn.PgError{Code: "40P01"}), codes.Aborted, ""},
+		{fmt.Errorf("failed to lookup subject: %w", context.DeadlineExceeded), codes.DeadlineExceeded, ""},
+		{status.Error(codes.NotFound, "subject does not exist"), codes.NotFound, ""},
+	}
+	for _, tc := range tests {
+		st := status.Convert(toStatusError(tc.err))
+		assert.Equal(t, tc.code, st.Code(), "expected %s got %s for %s", tc.code, st.Code(), tc.err)
+		assert.Equal(t, tc.field, violationField(st), "expected %s got %s for %s", tc.field, violationField(st), tc.err)
+	}
+
+	st := status.Convert(toStatusError(&pgconn.PgError{Code: "23505", TableName: "rules"}))
+	if assert.Len(t, st.Details(), 1) {
+		info, ok := st.Details()[0].(*errdetails.ResourceInfo)
+		if assert.True(t, ok, "expected resource info got %T", st.Details()[0]) {
+			assert.Equal(t, "rules", info.ResourceType, "expected %s got %s", "rules", info.ResourceType)
+		}
+	}
+}
@@ -0,0 +1,341 @@
+package compserv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"mime"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	evidence "github.com/rhmdnd/compserv/pkg/evidence"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// evidenceChunkSize is the size of the chunks DownloadEvidence sends.
+const evidenceChunkSize = 64 * 1024
+
+var sha256Pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+func toEvidenceMessage(e *models.Evidence) *Evidence {
+	return &Evidence{
+		Id:           e.ID,
+		ResultId:     e.ResultID.String,
+		AssessmentId: e.AssessmentID.String,
+		Name:         e.Name,
+		ContentType:  e.ContentType,
+		Sha256:       e.SHA256,
+		Size:         e.Size,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+	}
+}
+
+func (s *server) evidenceStore() (evidence.Store, error) {
+	if s.evidence == nil {
+		return nil, status.Error(codes.FailedPrecondition, "evidence storage is not configured")
+	}
+	return s.evidence, nil
+}
+
+// deleteEvidenceContents removes the contents of deleted evidence from the
+// store. The evidence is already gone from the database, so failures are
+// only logged, and the contents are removed even if the request that
+// deleted the evidence is canceled.
+func (s *server) deleteEvidenceContents(ids ...string) {
+	for _, id := range ids {
+		if s.evidence == nil {
+			log.Printf("Unable to delete contents of evidence %s, evidence storage is not configured", id)
+			continue
+		}
+		if err := s.evidence.Delete(context.Background(), id); err != nil {
+			log.Printf("Failed to delete contents of evidence %s: %s", id, err)
+		}
+	}
+}
+
+func getEvidence(db *gorm.DB, id string) (*models.Evidence, error) {
+	e := &models.Evidence{}
+	err := db.Where("id = ?", id).Take(e).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "evidence %s does not exist", id)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to lookup evidence %s: %w", id, err)
+	}
+	return e, nil
+}
+
+func validateEvidenceMetadata(m *EvidenceMetadata) error {
+	if m == nil {
+		return requiredField("metadata")
+	}
+	err := firstError(
+		checkRequired("metadata.name", m.GetName()),
+		checkRequired("metadata.contentType", m.GetContentType()),
+		checkLength("metadata.name", m.GetName(), maxNameLength),
+		checkLength("metadata.contentType", m.GetContentType(), maxNameLength),
+	)
+	if err != nil {
+		return err
+	}
+	switch {
+	case (m.GetResultId() == "") == (m.GetAssessmentId() == ""):
+		return invalidField("metadata.resultId", "one of resultId or assessmentId is required")
+	case m.GetResultId() != "":
+		err = checkUUID("metadata.resultId", m.GetResultId())
+	default:
+		err = checkUUID("metadata.assessmentId", m.GetAssessmentId())
+	}
+	if err != nil {
+		return err
+	}
+	if _, _, err := mime.ParseMediaType(m.GetContentType()); err != nil {
+		return invalidField("metadata.contentType", "%q is not a valid media type", m.GetContentType())
+	}
+	if d := m.GetSha256(); d != "" && !sha256Pattern.MatchString(strings.ToLower(d)) {
+		return invalidField("metadata.sha256", "sha256 must be a hex-encoded SHA-256 digest")
+	}
+	return nil
+}
+
+// findEvidenceTarget makes sure the result or assessment evidence is
+// attached to exists. Evidence can be attached to closed assessments, since
+// it's often collected after a scan.
+func findEvidenceTarget(db *gorm.DB, m *EvidenceMetadata) error {
+	if id := m.GetAssessmentId(); id != "" {
+		_, err := getAssessment(db, id)
+		if status.Code(err) == codes.NotFound {
+			return invalidField("metadata.assessmentId", "assessment %s does not exist", id)
+		}
+		return err
+	}
+	var count int64
+	if err := db.Model(&models.Result{}).Where("id = ?", m.GetResultId()).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to lookup result %s: %w", m.GetResultId(), err)
+	}
+	if count == 0 {
+		return invalidField("metadata.resultId", "result %s does not exist", m.GetResultId())
+	}
+	return nil
+}
+
+// evidenceReader reads the contents of evidence from an upload, hashing them
+// as they're read. Reading fails once the contents are larger than allowed.
+// Stores wrap the errors of readers, so the error that stopped the upload
+// is kept to return to the client.
+type evidenceReader struct {
+	stream  ComplianceService_UploadEvidenceServer
+	buf     []byte
+	hash    hash.Hash
+	size    int64
+	maxSize int64
+	err     error
+}
+
+func (r *evidenceReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		request, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		} else if err != nil {
+			r.err = err
+			return 0, err
+		}
+		if request.GetMetadata() != nil {
+			r.err = invalidField("metadata", "metadata can only be sent in the first message")
+			return 0, r.err
+		}
+		r.buf = request.GetData()
+	}
+	if r.size+int64(len(r.buf)) > r.maxSize {
+		r.err = invalidField("data", "evidence must be %d bytes or less", r.maxSize)
+		return 0, r.err
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.size += int64(n)
+	r.hash.Write(p[:n])
+	return n, nil
+}
+
+func (s *server) UploadEvidence(stream ComplianceService_UploadEvidenceServer) error {
+	store, err := s.evidenceStore()
+	if err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return requiredField("metadata")
+	} else if err != nil {
+		return err
+	}
+	m := first.GetMetadata()
+	if err := validateEvidenceMetadata(m); err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	db := s.database.WithContext(ctx)
+	if err := findEvidenceTarget(db, m); err != nil {
+		return toStatusError(err)
+	}
+
+	e := &models.Evidence{
+		ID:           uuid.NewString(),
+		ResultID:     toNullString(m.GetResultId()),
+		AssessmentID: toNullString(m.GetAssessmentId()),
+		Name:         m.GetName(),
+		ContentType:  m.GetContentType(),
+		CreatedAt:    time.Now().UTC(),
+	}
+	r := &evidenceReader{stream: stream, buf: first.GetData(), hash: sha256.New(), maxSize: s.maxEvidenceSize}
+	if err := store.Put(ctx, e.ID, e.ContentType, r); err != nil {
+		if r.err != nil {
+			return toStatusError(r.err)
+		}
+		return toStatusError(fmt.Errorf("failed to store evidence: %w", err))
+	}
+	e.Size = r.size
+	e.SHA256 = hex.EncodeToString(r.hash.Sum(nil))
+	if expected := strings.ToLower(m.GetSha256()); expected != "" && expected != e.SHA256 {
+		s.deleteEvidenceContents(e.ID)
+		return invalidField("metadata.sha256", "evidence has the digest %s instead of %s", e.SHA256, expected)
+	}
+	if err := db.Create(e).Error; err != nil {
+		s.deleteEvidenceContents(e.ID)
+		return toStatusError(fmt.Errorf("failed to create evidence: %w", err))
+	}
+	return stream.SendAndClose(toEvidenceMessage(e))
+}
+
+func (s *server) GetEvidence(ctx context.Context, request *GetEvidenceRequest) (*Evidence, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	e, err := getEvidence(s.database.WithContext(ctx), request.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toEvidenceMessage(e), nil
+}
+
+func (s *server) ListEvidence(ctx context.Context, request *ListEvidenceRequest) (*ListEvidenceResponse, error) {
+	if id := request.GetResultId(); id != "" {
+		if err := checkUUID("resultId", id); err != nil {
+			return nil, err
+		}
+	}
+	if id := request.GetAssessmentId(); id != "" {
+		if err := checkUUID("assessmentId", id); err != nil {
+			return nil, err
+		}
+	}
+	after, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	size := getPageSize(request.GetPageSize())
+
+	q := s.database.WithContext(ctx)
+	if id := request.GetResultId(); id != "" {
+		q = q.Where("result_id = ?", id)
+	}
+	if id := request.GetAssessmentId(); id != "" {
+		q = q.Where("assessment_id = ?", id)
+	}
+	if after != "" {
+		q = q.Where("id > ?", after)
+	}
+	var rows []models.Evidence
+	if err := q.Order("id").Limit(size + 1).Find(&rows).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &ListEvidenceResponse{}
+	if len(rows) > size {
+		rows = rows[:size]
+		response.NextPageToken = encodePageToken(rows[size-1].ID)
+	}
+	for i := range rows {
+		response.Evidence = append(response.Evidence, toEvidenceMessage(&rows[i]))
+	}
+	return response, nil
+}
+
+// DownloadEvidence checks the digest of the contents as they're sent, so
+// clients find out if the store returned something other than what was
+// uploaded.
+func (s *server) DownloadEvidence(request *DownloadEvidenceRequest,
+	stream ComplianceService_DownloadEvidenceServer,
+) error {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return err
+	}
+	store, err := s.evidenceStore()
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	e, err := getEvidence(s.database.WithContext(ctx), request.GetId())
+	if err != nil {
+		return toStatusError(err)
+	}
+	contents, err := store.Get(ctx, e.ID)
+	if errors.Is(err, evidence.ErrNotFound) {
+		return status.Errorf(codes.DataLoss, "contents of evidence %s are missing from the store", e.ID)
+	} else if err != nil {
+		return toStatusError(fmt.Errorf("failed to open evidence %s: %w", e.ID, err))
+	}
+	defer contents.Close()
+
+	h := sha256.New()
+	buf := make([]byte, evidenceChunkSize)
+	response := &DownloadEvidenceResponse{Evidence: toEvidenceMessage(e)}
+	for {
+		n, err := io.ReadFull(contents, buf)
+		if n > 0 || response.Evidence != nil {
+			h.Write(buf[:n])
+			// Messages can't be modified once they're sent, so each
+			// chunk gets a copy of the buffer.
+			response.Data = append([]byte(nil), buf[:n]...)
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+			response = &DownloadEvidenceResponse{}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return toStatusError(fmt.Errorf("failed to read evidence %s: %w", e.ID, err))
+		}
+	}
+	if digest := hex.EncodeToString(h.Sum(nil)); digest != e.SHA256 {
+		return status.Errorf(codes.DataLoss, "contents of evidence %s have the digest %s instead of %s",
+			e.ID, digest, e.SHA256)
+	}
+	return nil
+}
+
+func (s *server) DeleteEvidence(ctx context.Context, request *DeleteEvidenceRequest) (*DeleteEvidenceResponse, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	q := s.database.WithContext(ctx).Where("id = ?", request.GetId()).Delete(&models.Evidence{})
+	if q.Error != nil {
+		return nil, toStatusError(fmt.Errorf("failed to delete evidence %s: %w", request.GetId(), q.Error))
+	}
+	if q.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "evidence %s does not exist", request.GetId())
+	}
+	s.deleteEvidenceContents(request.GetId())
+	return &DeleteEvidenceResponse{}, nil
+}
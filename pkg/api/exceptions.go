@@ -0,0 +1,298 @@
+package compserv
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// exceptedOutcome is reported instead of the outcome of failures covered by
+// an active exception.
+const exceptedOutcome = "EXCEPTED"
+
+const maxJustificationLength = 4096
+
+// activeExceptionSQL selects the ID of an active exception covering a
+// failed result, or NULL if there isn't one. Exceptions cover the subject
+// they were created for and its descendants, so the ancestry of the
+// result's subject is walked like subjectAncestryCTE does. Control
+// exceptions cover results for the control and its enhancements, and
+// results of rules mapped to them, like controlResultsCondition. If more
+// than one exception applies, the one that expires last is used. It expects
+// a maximum depth and the current time as arguments, and the query must
+// select from results.
+const activeExceptionSQL = `CASE WHEN UPPER(results.outcome) IN ('FAIL', 'FAILED') THEN (
+	WITH RECURSIVE lineage AS (
+		SELECT results.subject_id AS id, 0 AS depth
+		UNION ALL
+		SELECT subjects.parent_id, lineage.depth + 1 FROM lineage
+		JOIN subjects ON subjects.id = lineage.id
+		WHERE subjects.parent_id IS NOT NULL AND lineage.depth < ?
+	)
+	SELECT exceptions.id FROM exceptions
+	LEFT JOIN controls AS excepted ON excepted.id = exceptions.control_id
+	WHERE exceptions.subject_id IN (SELECT id FROM lineage)
+	AND exceptions.revoked_at IS NULL AND exceptions.expires_at > ?
+	AND (exceptions.rule_id = results.rule_id OR EXISTS (
+		SELECT 1 FROM controls AS named
+		LEFT JOIN controls AS parents ON parents.id = named.parent_id
+		WHERE excepted.name IN (named.name, parents.name)
+		AND (named.name = (SELECT reported.name FROM controls AS reported WHERE reported.id = results.control_id)
+			OR named.id IN (SELECT rule_controls.control_id FROM rule_controls
+				WHERE rule_controls.rule_id = results.rule_id))
+	))
+	ORDER BY exceptions.expires_at DESC, exceptions.id
+	LIMIT 1
+) END`
+
+// activeException returns activeExceptionSQL along with its arguments.
+// Exceptions lapse on their own, so whether one is active depends on when
+// we ask.
+func activeException(now time.Time) (string, []interface{}) {
+	return activeExceptionSQL, []interface{}{maxSubjectDepth, now}
+}
+
+// effectiveOutcome returns an expression selecting the outcome of a result,
+// or exceptedOutcome if an active exception covers it.
+func effectiveOutcome(now time.Time) (string, []interface{}) {
+	expr, args := activeException(now)
+	return "CASE WHEN (" + expr + ") IS NOT NULL THEN '" + exceptedOutcome + "' ELSE results.outcome END", args
+}
+
+// exceptionRow is an exception along with the names of the control or rule
+// it covers.
+type exceptionRow struct {
+	models.Exception
+	Control sql.NullString
+	Rule    sql.NullString
+}
+
+func selectExceptions(db *gorm.DB) *gorm.DB {
+	return db.Table("exceptions").
+		Select("exceptions.*, controls.name AS control, rules.name AS rule").
+		Joins("LEFT JOIN controls ON controls.id = exceptions.control_id").
+		Joins("LEFT JOIN rules ON rules.id = exceptions.rule_id")
+}
+
+func getException(db *gorm.DB, id string) (*exceptionRow, error) {
+	row := &exceptionRow{}
+	q := selectExceptions(db).Where("exceptions.id = ?", id).Limit(1).Scan(row)
+	if q.Error != nil {
+		return nil, fmt.Errorf("failed to lookup exception %s: %w", id, q.Error)
+	}
+	if q.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "exception %s does not exist", id)
+	}
+	return row, nil
+}
+
+func exceptionState(e *models.Exception, now time.Time) ExceptionState {
+	switch {
+	case e.RevokedAt.Valid:
+		return ExceptionState_EXCEPTION_STATE_REVOKED
+	case !e.ExpiresAt.After(now):
+		return ExceptionState_EXCEPTION_STATE_EXPIRED
+	default:
+		return ExceptionState_EXCEPTION_STATE_ACTIVE
+	}
+}
+
+func toExceptionMessage(row *exceptionRow, now time.Time) *Exception {
+	m := &Exception{
+		Id:            row.ID,
+		SubjectId:     row.SubjectID,
+		ControlId:     row.ControlID.String,
+		Control:       row.Control.String,
+		RuleId:        row.RuleID.String,
+		Rule:          row.Rule.String,
+		Justification: row.Justification,
+		Approver:      row.Approver,
+		ExpiresAt:     timestamppb.New(row.ExpiresAt),
+		CreatedAt:     timestamppb.New(row.CreatedAt),
+		State:         exceptionState(&row.Exception, now),
+	}
+	if row.RevokedAt.Valid {
+		m.RevokedAt = timestamppb.New(row.RevokedAt.Time)
+	}
+	return m
+}
+
+func validateCreateExceptionRequest(r *CreateExceptionRequest, now time.Time) error {
+	err := firstError(
+		checkUUID("subjectId", r.GetSubjectId()),
+		checkRequired("justification", r.GetJustification()),
+		checkRequired("approver", r.GetApprover()),
+		checkLength("control", r.GetControl(), maxNameLength),
+		checkLength("rule", r.GetRule(), maxNameLength),
+		checkLength("justification", r.GetJustification(), maxJustificationLength),
+		checkLength("approver", r.GetApprover(), maxNameLength),
+	)
+	if err != nil {
+		return err
+	}
+	if (r.GetControl() == "") == (r.GetRule() == "") {
+		return invalidField("control", "one of control or rule is required")
+	}
+	if r.GetExpiresAt() == nil {
+		return requiredField("expiresAt")
+	}
+	if err := r.GetExpiresAt().CheckValid(); err != nil {
+		return invalidField("expiresAt", "expiresAt is not a valid timestamp: %s", err)
+	}
+	if !r.GetExpiresAt().AsTime().After(now) {
+		return invalidField("expiresAt", "expiresAt must be in the future")
+	}
+	return nil
+}
+
+func (s *server) CreateException(ctx context.Context, request *CreateExceptionRequest) (*Exception, error) {
+	now := time.Now().UTC()
+	if err := validateCreateExceptionRequest(request, now); err != nil {
+		return nil, err
+	}
+
+	e := &exceptionRow{
+		Exception: models.Exception{
+			ID:            uuid.NewString(),
+			SubjectID:     request.GetSubjectId(),
+			Justification: request.GetJustification(),
+			Approver:      request.GetApprover(),
+			ExpiresAt:     request.GetExpiresAt().AsTime().UTC(),
+			CreatedAt:     now,
+		},
+		Control: toNullString(request.GetControl()),
+		Rule:    toNullString(request.GetRule()),
+	}
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := findSubject(tx, request.GetSubjectId())
+		if status.Code(err) == codes.NotFound {
+			return invalidField("subjectId", "subject %s does not exist", request.GetSubjectId())
+		} else if err != nil {
+			return err
+		}
+		if request.GetControl() != "" {
+			c, err := findControl(tx, request.GetControl())
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return invalidField("control", "control %s does not exist", request.GetControl())
+			} else if err != nil {
+				return fmt.Errorf("failed to lookup control %s: %w", request.GetControl(), err)
+			}
+			e.ControlID = toNullString(c.ID)
+		} else {
+			r := models.Rule{}
+			err := tx.Where("name = ?", request.GetRule()).Take(&r).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return invalidField("rule", "rule %s does not exist", request.GetRule())
+			} else if err != nil {
+				return fmt.Errorf("failed to lookup rule %s: %w", request.GetRule(), err)
+			}
+			e.RuleID = toNullString(r.ID)
+		}
+		if err := tx.Create(&e.Exception).Error; err != nil {
+			return fmt.Errorf("failed to create exception: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toExceptionMessage(e, now), nil
+}
+
+func (s *server) GetException(ctx context.Context, request *GetExceptionRequest) (*Exception, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	e, err := getException(s.database.WithContext(ctx), request.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toExceptionMessage(e, time.Now().UTC()), nil
+}
+
+func (s *server) ListExceptions(ctx context.Context, request *ListExceptionsRequest) (*ListExceptionsResponse, error) {
+	if id := request.GetSubjectId(); id != "" {
+		if err := checkUUID("subjectId", id); err != nil {
+			return nil, err
+		}
+	}
+	after, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	size := getPageSize(request.GetPageSize())
+
+	now := time.Now().UTC()
+	q := selectExceptions(s.database.WithContext(ctx))
+	if id := request.GetSubjectId(); id != "" {
+		q = q.Where("exceptions.subject_id = ?", id)
+	}
+	switch request.GetState() {
+	case ExceptionState_EXCEPTION_STATE_UNSPECIFIED:
+	case ExceptionState_EXCEPTION_STATE_ACTIVE:
+		q = q.Where("exceptions.revoked_at IS NULL AND exceptions.expires_at > ?", now)
+	case ExceptionState_EXCEPTION_STATE_EXPIRED:
+		q = q.Where("exceptions.revoked_at IS NULL AND exceptions.expires_at <= ?", now)
+	case ExceptionState_EXCEPTION_STATE_REVOKED:
+		q = q.Where("exceptions.revoked_at IS NOT NULL")
+	default:
+		return nil, invalidField("state", "unknown exception state %d", request.GetState())
+	}
+	if after != "" {
+		q = q.Where("exceptions.id > ?", after)
+	}
+	var rows []exceptionRow
+	if err := q.Order("exceptions.id").Limit(size + 1).Scan(&rows).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &ListExceptionsResponse{}
+	if len(rows) > size {
+		rows = rows[:size]
+		response.NextPageToken = encodePageToken(rows[size-1].ID)
+	}
+	for i := range rows {
+		response.Exceptions = append(response.Exceptions, toExceptionMessage(&rows[i], now))
+	}
+	return response, nil
+}
+
+func (s *server) RevokeException(ctx context.Context, request *RevokeExceptionRequest) (*Exception, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var e *exceptionRow
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		e, err = getException(tx.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "exceptions"}}),
+			request.GetId())
+		if err != nil {
+			return err
+		}
+		if e.RevokedAt.Valid {
+			return status.Errorf(codes.FailedPrecondition, "exception %s is already revoked", e.ID)
+		}
+		e.RevokedAt = sql.NullTime{Time: now, Valid: true}
+		err = tx.Model(&models.Exception{}).Where("id = ?", e.ID).Update("revoked_at", e.RevokedAt).Error
+		if err != nil {
+			return fmt.Errorf("failed to revoke exception %s: %w", e.ID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toExceptionMessage(e, now), nil
+}
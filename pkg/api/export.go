@@ -0,0 +1,396 @@
+package compserv
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	oscal "github.com/rhmdnd/compserv/pkg/oscal"
+	"gorm.io/gorm"
+)
+
+// oscalNamespace qualifies the props we add to OSCAL documents, since
+// they aren't defined by OSCAL itself.
+const oscalNamespace = "https://github.com/rhmdnd/compserv/ns/oscal"
+
+// exportVersion is the document version of exported assessments that don't
+// have one in their metadata.
+const exportVersion = "1.0.0"
+
+// exportUUIDSpace is the namespace of the UUIDs we derive for parts of an
+// OSCAL document that don't have a row of their own, like findings. Deriving
+// them keeps exports of the same assessment stable.
+var exportUUIDSpace = uuid.MustParse("df2df70c-ede1-4b50-b078-fb7fdb694319")
+
+func exportUUID(parts ...string) string {
+	return uuid.NewSHA1(exportUUIDSpace, []byte(strings.Join(parts, "/"))).String()
+}
+
+// exportRow is a result along with the subject it's for.
+type exportRow struct {
+	ID          string
+	Name        string
+	Outcome     string
+	Instruction sql.NullString
+	Rationale   sql.NullString
+	SubjectID   sql.NullString
+	Subject     sql.NullString
+	SubjectType sql.NullString
+	ControlID   sql.NullString
+	RuleID      sql.NullString
+	CreatedAt   sql.NullTime
+}
+
+// exportControl is a control results were reported for, either directly or
+// through the rule they're for.
+type exportControl struct {
+	RuleID  sql.NullString
+	ID      string
+	Name    string
+	OscalID sql.NullString
+	Title   sql.NullString
+}
+
+// assessmentExport holds everything we render as an OSCAL assessment
+// results document.
+type assessmentExport struct {
+	assessment *models.Assessment
+	// metadata is linked to the assessment, if it has any.
+	metadata *models.Metadata
+	results  []exportRow
+	controls map[string]*exportControl
+	// ruleControls are the IDs of the controls mapped to each rule.
+	ruleControls map[string][]string
+}
+
+func loadAssessmentExport(db *gorm.DB, id string) (*assessmentExport, error) {
+	a, err := getAssessment(db, id)
+	if err != nil {
+		return nil, err
+	}
+	e := &assessmentExport{assessment: a, controls: map[string]*exportControl{}, ruleControls: map[string][]string{}}
+	if a.MetadataID.Valid {
+		md := &models.Metadata{}
+		err := db.Where("id = ?", a.MetadataID.String).Take(md).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to lookup metadata of assessment %s: %w", id, err)
+		} else if err == nil {
+			e.metadata = md
+		}
+	}
+
+	// Like DiffAssessments, only the latest result for each subject,
+	// control and rule is exported.
+	err = db.Table("results").
+		Select("DISTINCT ON (results.subject_id, results.control_id, results.name) "+
+			"results.id, results.name, results.outcome, results.instruction, results.rationale, "+
+			"results.subject_id, subjects.name AS subject, subjects.type AS subject_type, "+
+			"results.control_id, results.rule_id, metadata.created_at").
+		Joins("LEFT JOIN subjects ON subjects.id = results.subject_id").
+		Joins("LEFT JOIN metadata ON metadata.id = results.metadata_id").
+		Where("results.assessment_id = ?", id).
+		Order("results.subject_id, results.control_id, results.name, metadata.created_at DESC NULLS LAST, results.id DESC").
+		Scan(&e.results).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup results of assessment %s: %w", id, err)
+	}
+	sort.Slice(e.results, func(i, j int) bool {
+		a, b := e.results[i], e.results[j]
+		if a.Subject.String != b.Subject.String {
+			return a.Subject.String < b.Subject.String
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+
+	var controlIDs, ruleIDs []string
+	for _, r := range e.results {
+		if r.ControlID.Valid {
+			controlIDs = append(controlIDs, r.ControlID.String)
+		}
+		if r.RuleID.Valid {
+			ruleIDs = append(ruleIDs, r.RuleID.String)
+		}
+	}
+	var controls []exportControl
+	err = db.Table("controls").Select("id, name, oscal_id, title").Where("id IN ?", controlIDs).Scan(&controls).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup controls of assessment %s: %w", id, err)
+	}
+	var mapped []exportControl
+	err = db.Table("rule_controls").
+		Select("rule_controls.rule_id, controls.id, controls.name, controls.oscal_id, controls.title").
+		Joins("JOIN controls ON controls.id = rule_controls.control_id").
+		Where("rule_controls.rule_id IN ?", ruleIDs).
+		Scan(&mapped).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup controls of rules: %w", err)
+	}
+	controls = append(controls, mapped...)
+	for i := range controls {
+		c := &controls[i]
+		if c.RuleID.Valid {
+			e.ruleControls[c.RuleID.String] = append(e.ruleControls[c.RuleID.String], c.ID)
+		}
+		if _, ok := e.controls[c.ID]; !ok {
+			e.controls[c.ID] = c
+		}
+	}
+	return e, nil
+}
+
+// appendProp adds one of our props, unless there's no value. OSCAL doesn't
+// allow empty values.
+func appendProp(props []oscal.Prop, name, value string) []oscal.Prop {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return props
+	}
+	return append(props, oscal.Prop{Name: name, NS: oscalNamespace, Value: value})
+}
+
+func (e *assessmentExport) title() string {
+	if e.assessment.Name.Valid && e.assessment.Name.String != "" {
+		return e.assessment.Name.String
+	}
+	return "Assessment " + e.assessment.ID
+}
+
+// start returns when the assessment started, falling back to now for
+// assessments that didn't record it.
+func (e *assessmentExport) start(now time.Time) time.Time {
+	if e.assessment.StartedAt.Valid {
+		return e.assessment.StartedAt.Time.UTC()
+	}
+	return now
+}
+
+// resultControls returns the IDs of the controls a result counts towards,
+// its own control and the ones mapped to its rule.
+func (e *assessmentExport) resultControls(r *exportRow) []string {
+	seen := map[string]bool{}
+	var ids []string
+	for _, id := range append([]string{r.ControlID.String}, e.ruleControls[r.RuleID.String]...) {
+		if _, ok := e.controls[id]; ok && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// inventoryItems returns an inventory item for every subject with results.
+func (e *assessmentExport) inventoryItems() []oscal.InventoryItem {
+	var items []oscal.InventoryItem
+	seen := map[string]bool{}
+	for _, r := range e.results {
+		if !r.SubjectID.Valid || seen[r.SubjectID.String] {
+			continue
+		}
+		seen[r.SubjectID.String] = true
+		item := oscal.InventoryItem{UUID: r.SubjectID.String, Description: r.Subject.String}
+		item.Props = appendProp(item.Props, "subject-name", r.Subject.String)
+		item.Props = appendProp(item.Props, "subject-type", r.SubjectType.String)
+		items = append(items, item)
+	}
+	return items
+}
+
+func (e *assessmentExport) observation(r *exportRow, now time.Time) oscal.Observation {
+	o := oscal.Observation{
+		UUID:        r.ID,
+		Title:       r.Name,
+		Description: strings.TrimSpace(r.Rationale.String),
+		Methods:     []string{"TEST"},
+		Collected:   e.start(now),
+		Remarks:     strings.TrimSpace(r.Instruction.String),
+	}
+	if o.Description == "" {
+		o.Description = fmt.Sprintf("Result of %s.", r.Name)
+	}
+	if r.CreatedAt.Valid {
+		o.Collected = r.CreatedAt.Time.UTC()
+	}
+	o.Props = appendProp(o.Props, "rule", r.Name)
+	o.Props = appendProp(o.Props, "outcome", r.Outcome)
+	if r.SubjectID.Valid {
+		o.Subjects = []oscal.SubjectReference{{
+			SubjectUUID: r.SubjectID.String,
+			Type:        "inventory-item",
+			Title:       r.Subject.String,
+		}}
+	}
+	return o
+}
+
+// findings returns a finding for every control with results. A control is
+// satisfied when all of its results passed, and not satisfied if any of them
+// failed or errored. Controls with other outcomes, like MANUAL, aren't
+// satisfied by the evidence we have.
+func (e *assessmentExport) findings() []oscal.Finding {
+	observations := map[string][]oscal.RelatedObservation{}
+	outcomes := map[string]map[int]int{}
+	for i := range e.results {
+		r := &e.results[i]
+		for _, id := range e.resultControls(r) {
+			observations[id] = append(observations[id], oscal.RelatedObservation{ObservationUUID: r.ID})
+			if outcomes[id] == nil {
+				outcomes[id] = map[int]int{}
+			}
+			outcomes[id][classifyOutcome(r.Outcome)]++
+		}
+	}
+
+	ids := make([]string, 0, len(observations))
+	for id := range observations {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := e.controls[ids[i]], e.controls[ids[j]]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+
+	findings := make([]oscal.Finding, 0, len(ids))
+	for _, id := range ids {
+		c, counts := e.controls[id], outcomes[id]
+		status := oscal.ObjectiveStatus{State: oscal.StateNotSatisfied, Reason: oscal.ReasonOther}
+		switch {
+		case counts[outcomeFailed] > 0 || counts[outcomeErrored] > 0:
+			status.Reason = oscal.ReasonFail
+		case counts[outcomePassed] == len(observations[id]):
+			status = oscal.ObjectiveStatus{State: oscal.StateSatisfied, Reason: oscal.ReasonPass}
+		}
+		findings = append(findings, oscal.Finding{
+			UUID:  exportUUID(e.assessment.ID, "finding", id),
+			Title: c.Name,
+			Description: fmt.Sprintf("%d of %d results for %s passed, %d failed and %d errored.",
+				counts[outcomePassed], len(observations[id]), c.Name, counts[outcomeFailed], counts[outcomeErrored]),
+			Target: oscal.FindingTarget{
+				Type:     "objective-id",
+				TargetID: controlToken(c),
+				Title:    strings.TrimSpace(c.Title.String),
+				Status:   status,
+			},
+			RelatedObservations: observations[id],
+		})
+	}
+	return findings
+}
+
+// controlToken returns the OSCAL ID of a control. Controls that weren't
+// imported from a catalog are identified by their name instead.
+func controlToken(c *exportControl) string {
+	if c.OscalID.Valid && c.OscalID.String != "" {
+		return oscal.Token(c.OscalID.String)
+	}
+	return oscal.Token(c.Name)
+}
+
+// document renders the assessment as an OSCAL assessment results document.
+// The assessment is the single result of the document, so the result keeps
+// the ID of the assessment. Without an assessment plan, the document imports
+// a back matter resource saying so, since OSCAL requires one.
+func (e *assessmentExport) document(planHref string, now time.Time) *oscal.AssessmentResults {
+	a := e.assessment
+	ar := &oscal.AssessmentResults{
+		UUID: exportUUID(a.ID, "assessment-results"),
+		Metadata: oscal.Metadata{
+			Title:        "Assessment Results: " + e.title(),
+			LastModified: now.Format(time.RFC3339),
+			Version:      exportVersion,
+			OscalVersion: oscal.Version,
+		},
+		ImportAP: oscal.ImportAP{Href: planHref},
+	}
+	if a.FinishedAt.Valid {
+		ar.Metadata.LastModified = a.FinishedAt.Time.UTC().Format(time.RFC3339)
+	}
+	if e.metadata != nil && e.metadata.Version.Valid && e.metadata.Version.String != "" {
+		ar.Metadata.Version = e.metadata.Version.String
+	}
+	if planHref == "" {
+		plan := oscal.Resource{
+			UUID:        exportUUID(a.ID, "assessment-plan"),
+			Title:       "Assessment Plan",
+			Description: "The assessment wasn't performed according to an OSCAL assessment plan.",
+		}
+		ar.ImportAP.Href = "#" + plan.UUID
+		ar.BackMatter = &oscal.BackMatter{Resources: []oscal.Resource{plan}}
+	}
+
+	result := oscal.Result{
+		UUID:        a.ID,
+		Title:       e.title(),
+		Description: fmt.Sprintf("Results of %s.", e.title()),
+		Start:       e.start(now),
+	}
+	if e.metadata != nil && strings.TrimSpace(e.metadata.Description.String) != "" {
+		result.Description = strings.TrimSpace(e.metadata.Description.String)
+	}
+	if a.FinishedAt.Valid {
+		end := a.FinishedAt.Time.UTC()
+		result.End = &end
+	}
+	result.Props = appendProp(result.Props, "assessment-state", strings.ToLower(a.State))
+	if items := e.inventoryItems(); len(items) > 0 {
+		result.LocalDefinitions = &oscal.LocalDefinitions{InventoryItems: items}
+	}
+	for i := range e.results {
+		result.Observations = append(result.Observations, e.observation(&e.results[i], now))
+	}
+	result.Findings = e.findings()
+
+	selection := oscal.ReviewedControlSelection{}
+	seen := map[string]bool{}
+	for _, f := range result.Findings {
+		if !seen[f.Target.TargetID] {
+			seen[f.Target.TargetID] = true
+			selection.IncludeControls = append(selection.IncludeControls, oscal.SelectControlByID{ControlID: f.Target.TargetID})
+		}
+	}
+	if len(selection.IncludeControls) == 0 {
+		selection.Description = "No controls were assessed."
+	}
+	result.ReviewedControls.ControlSelections = []oscal.ReviewedControlSelection{selection}
+	ar.Results = []oscal.Result{result}
+	return ar
+}
+
+func (s *server) ExportAssessmentResults(ctx context.Context,
+	request *ExportAssessmentResultsRequest,
+) (*ExportAssessmentResultsResponse, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	if href := request.GetAssessmentPlanHref(); href != "" {
+		if _, err := url.Parse(href); err != nil {
+			return nil, invalidField("assessmentPlanHref", "assessmentPlanHref %q is not a valid URI", href)
+		}
+	}
+
+	e, err := loadAssessmentExport(s.database.WithContext(ctx), request.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	doc := oscal.AssessmentResultsDocument{
+		AssessmentResults: *e.document(request.GetAssessmentPlanHref(), time.Now().UTC()),
+	}
+	content, err := json.MarshalIndent(&doc, "", "  ")
+	if err != nil {
+		return nil, toStatusError(fmt.Errorf("failed to encode assessment results: %w", err))
+	}
+	return &ExportAssessmentResultsResponse{Content: content}, nil
+}
@@ -0,0 +1,89 @@
+package compserv
+
+import (
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	models "github.com/rhmdnd/compserv/pkg/models"
+	oscal "github.com/rhmdnd/compserv/pkg/oscal"
+	"github.com/stretchr/testify/assert"
+)
+
+// These are the patterns the OSCAL schema uses for UUIDs and tokens.
+var (
+	oscalUUID = regexp.MustCompile(
+		`^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[45][0-9A-Fa-f]{3}-[89ABab][0-9A-Fa-f]{3}-[0-9A-Fa-f]{12}$`)
+	oscalToken = regexp.MustCompile(`^(\p{L}|_)(\p{L}|\p{N}|[.\-_])*$`)
+)
+
+func TestAssessmentExportDocument(t *testing.T) {
+	t.Parallel()
+	started := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	subjectID := "0b6f4a7e-2c4d-4e8f-9a1b-3c5d7e9f1a2b"
+	e := &assessmentExport{
+		assessment: &models.Assessment{
+			ID:        "8c3c8c6e-1b7b-4d1e-9a8e-6f5d2c1b0a9f",
+			Name:      toNullString("nightly scan"),
+			State:     assessmentInProgress,
+			StartedAt: sql.NullTime{Time: started, Valid: true},
+		},
+		results: []exportRow{
+			{
+				ID: "1f2e3d4c-5b6a-4978-8a6b-5c4d3e2f1a0b", Name: "accounts-disabled", Outcome: "PASS",
+				SubjectID: toNullString(subjectID), Subject: toNullString("node-1"), SubjectType: toNullString("node"),
+				ControlID: toNullString("c1"), RuleID: toNullString("r1"),
+			},
+			{
+				ID: "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d", Name: "audit-enabled", Outcome: "FAIL",
+				SubjectID: toNullString(subjectID), Subject: toNullString("node-1"), ControlID: toNullString("c2"),
+			},
+		},
+		controls: map[string]*exportControl{
+			"c1": {ID: "c1", Name: "AC-2", OscalID: toNullString("ac-2"), Title: toNullString("Account Management")},
+			"c2": {ID: "c2", Name: "CIS 5.1.1"},
+			"c3": {ID: "c3", Name: "AC-2(1)"},
+		},
+		ruleControls: map[string][]string{"r1": {"c3"}},
+	}
+	ar := e.document("", started.Add(time.Hour))
+
+	assert.Regexp(t, oscalUUID, ar.UUID)
+	assert.Equal(t, "#"+ar.BackMatter.Resources[0].UUID, ar.ImportAP.Href)
+	assert.Equal(t, "Assessment Results: nightly scan", ar.Metadata.Title)
+	if !assert.Len(t, ar.Results, 1) {
+		return
+	}
+	r := ar.Results[0]
+	assert.Equal(t, e.assessment.ID, r.UUID, "expected %s got %s", e.assessment.ID, r.UUID)
+	assert.Equal(t, started, r.Start)
+	assert.Nil(t, r.End)
+	assert.Equal(t, subjectID, r.LocalDefinitions.InventoryItems[0].UUID)
+	assert.Len(t, r.Observations, 2)
+	assert.Equal(t, subjectID, r.Observations[0].Subjects[0].SubjectUUID)
+	assert.Equal(t, started, r.Observations[0].Collected)
+
+	// The result for accounts-disabled counts towards AC-2(1) through its rule
+	states := map[string]string{}
+	for _, f := range r.Findings {
+		assert.Regexp(t, oscalUUID, f.UUID)
+		assert.Regexp(t, oscalToken, f.Target.TargetID)
+		states[f.Target.TargetID] = f.Target.Status.State
+	}
+	expected := map[string]string{
+		"ac-2":      oscal.StateSatisfied,
+		"ac-2.1":    oscal.StateSatisfied,
+		"cis_5.1.1": oscal.StateNotSatisfied,
+	}
+	assert.Equal(t, expected, states)
+	assert.Len(t, r.ReviewedControls.ControlSelections[0].IncludeControls, 3)
+
+	content, err := json.Marshal(&oscal.AssessmentResultsDocument{AssessmentResults: *ar})
+	if err != nil {
+		t.Fatalf("Unable to encode assessment results: %s", err)
+	}
+	assert.Contains(t, string(content), `"start":"2022-06-01T12:00:00Z"`)
+	assert.Contains(t, string(content), `"oscal-version":"`+oscal.Version+`"`)
+}
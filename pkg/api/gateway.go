@@ -0,0 +1,235 @@
+package compserv
+
+import (
+	"context"
+	_ "embed" // embeds the OpenAPI document
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// openAPIDocument describes the REST endpoints. It's generated from
+// compserv.proto along with the gateway, see the grpc target of the
+// Makefile.
+//
+//go:embed compserv.swagger.json
+var openAPIDocument []byte
+
+// NewGateway returns an HTTP handler exposing every ComplianceService RPC as
+// a REST endpoint, as described by the google.api.http options in
+// compserv.proto. Requests are proxied to the gRPC server at endpoint and
+// messages are encoded as protobuf JSON. The OpenAPI document is served from
+// /openapi.json, exports of results are downloaded from /v1/results:export,
+// evidence is uploaded by posting its contents to /v1/evidence, and
+// downloaded from /v1/evidence/{id}:download.
+func NewGateway(ctx context.Context, endpoint string, opts ...grpc.DialOption) (http.Handler, error) {
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			log.Printf("Failed to close gateway connection to %s: %s", endpoint, err)
+		}
+	}()
+	client := NewComplianceServiceClient(conn)
+
+	mux := runtime.NewServeMux()
+	if err := RegisterComplianceServiceHandlerClient(ctx, mux, client); err != nil {
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodGet, "/v1/results:export", exportResultsHandler(mux, client)); err != nil {
+		return nil, err
+	}
+	if err := mux.HandlePath(http.MethodPost, "/v1/evidence", uploadEvidenceHandler(mux, client)); err != nil {
+		return nil, err
+	}
+	err = mux.HandlePath(http.MethodGet, "/v1/evidence/{id}:download", downloadEvidenceHandler(mux, client))
+	if err != nil {
+		return nil, err
+	}
+	handler := http.NewServeMux()
+	handler.Handle("/", mux)
+	handler.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openAPIDocument)
+	})
+	return handler, nil
+}
+
+// exportResultsHandler downloads an export as a file. The query parameters
+// are the fields of ExportResultsRequest, like the parameters of the other
+// endpoints. Errors are only reported with an HTTP status until the first
+// chunk is written. After that, the connection is aborted so clients don't
+// mistake part of an export for all of it.
+func exportResultsHandler(mux *runtime.ServeMux, client ComplianceServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(ctx, mux, r, "/.ComplianceService/ExportResults")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		request := &ExportResultsRequest{}
+		if err := r.ParseForm(); err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+		filter := &utilities.DoubleArray{Encoding: map[string]int{}}
+		if err := runtime.PopulateQueryParameters(request, r.Form, filter); err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+		format, err := getExportFormat(request.GetFormat())
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		stream, err := client.ExportResults(ctx, request)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		// Wait for the first chunk so invalid requests get an error status.
+		chunk, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", exportContentTypes[format])
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf(`attachment; filename="results.%s"`, exportFileExtensions[format]))
+		flusher, _ := w.(http.Flusher)
+		for err == nil {
+			if _, err := w.Write(chunk.GetData()); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			chunk, err = stream.Recv()
+		}
+		if !errors.Is(err, io.EOF) {
+			log.Printf("Export of results failed after it started: %s", err)
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+// uploadEvidenceHandler uploads the body of the request as evidence. The
+// query parameters are the fields of EvidenceMetadata, and the content type
+// defaults to the Content-Type of the request. The query is parsed on its
+// own, since parsing the form would consume form encoded evidence.
+func uploadEvidenceHandler(mux *runtime.ServeMux, client ComplianceServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(ctx, mux, r, "/.ComplianceService/UploadEvidence")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		metadata := &EvidenceMetadata{}
+		filter := &utilities.DoubleArray{Encoding: map[string]int{}}
+		if err := runtime.PopulateQueryParameters(metadata, r.URL.Query(), filter); err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+		if metadata.ContentType == "" {
+			metadata.ContentType = r.Header.Get("Content-Type")
+		}
+
+		stream, err := client.UploadEvidence(ctx)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		request := &UploadEvidenceRequest{Metadata: metadata}
+		buf := make([]byte, evidenceChunkSize)
+		for {
+			n, err := io.ReadFull(r.Body, buf)
+			if n > 0 || request.Metadata != nil {
+				request.Data = append([]byte(nil), buf[:n]...)
+				// The server stopped reading, CloseAndRecv returns why.
+				if err := stream.Send(request); err != nil {
+					break
+				}
+				request = &UploadEvidenceRequest{}
+			}
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			} else if err != nil {
+				runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+				return
+			}
+		}
+		evidence, err := stream.CloseAndRecv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, evidence)
+	}
+}
+
+// downloadEvidenceHandler downloads evidence as a file, with the content type
+// and name it was uploaded with. Like exportResultsHandler, the connection is
+// aborted if the download fails after it started.
+func downloadEvidenceHandler(mux *runtime.ServeMux, client ComplianceServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(ctx, mux, r, "/.ComplianceService/DownloadEvidence")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		stream, err := client.DownloadEvidence(ctx, &DownloadEvidenceRequest{Id: params["id"]})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		// The first message describes the evidence, and invalid requests
+		// fail before it's sent.
+		chunk, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		e := chunk.GetEvidence()
+		w.Header().Set("Content-Type", e.GetContentType())
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": e.GetName()}))
+		w.Header().Set("Content-Length", strconv.FormatInt(e.GetSize(), 10))
+		for err == nil {
+			if _, err := w.Write(chunk.GetData()); err != nil {
+				return
+			}
+			chunk, err = stream.Recv()
+		}
+		if !errors.Is(err, io.EOF) {
+			log.Printf("Download of evidence %s failed after it started: %s", e.GetId(), err)
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package compserv
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"gorm.io/gorm"
+)
+
+// NewHealthServer returns a grpc.health.v1 server for the service. The
+// server as a whole, the empty service name, is serving while the process
+// is up, so it's suitable for liveness checks. ComplianceService is only
+// serving while the database is reachable, which is checked by pinging it
+// every interval until the context is done.
+func NewHealthServer(ctx context.Context, db *gorm.DB, interval time.Duration) *health.Server {
+	hs := health.NewServer()
+	checkDatabase(ctx, db, hs, interval)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				hs.Shutdown()
+				return
+			case <-ticker.C:
+				checkDatabase(ctx, db, hs, interval)
+			}
+		}
+	}()
+	return hs
+}
+
+// checkDatabase pings the database and updates the status of the service.
+// The ping has to finish before the next one is due.
+func checkDatabase(ctx context.Context, db *gorm.DB, hs *health.Server, timeout time.Duration) {
+	st := healthpb.HealthCheckResponse_SERVING
+	if err := pingDatabase(ctx, db, timeout); err != nil {
+		log.Printf("Database health check failed: %s", err)
+		st = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	hs.SetServingStatus(ComplianceService_ServiceDesc.ServiceName, st)
+}
+
+func pingDatabase(ctx context.Context, db *gorm.DB, timeout time.Duration) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
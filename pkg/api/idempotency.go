@@ -0,0 +1,123 @@
+package compserv
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// idempotencyLockClass namespaces the advisory locks taken on idempotency
+// keys so they don't collide with other advisory locks.
+const idempotencyLockClass = 0x6b657973
+
+// requestHash identifies the content of a result request, ignoring its
+// idempotency key.
+func requestHash(r *ResultRequest) (string, error) {
+	c, _ := proto.Clone(r).(*ResultRequest)
+	c.IdempotencyKey = ""
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash result request: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// keyedResult is a result persisted with an idempotency key.
+type keyedResult struct {
+	ID          string
+	RequestHash string
+}
+
+// idempotentResults tracks the idempotency keys used by a batch of result
+// requests, including the ones that were persisted earlier.
+type idempotentResults struct {
+	results map[string]keyedResult
+}
+
+// newIdempotentResults locks the idempotency keys of the requests until the
+// transaction ends, then looks up the results already persisted with them.
+// Holding the locks means a concurrent retry waits for us to commit instead
+// of failing on the unique constraint. Keys are locked in order so
+// overlapping batches can't deadlock.
+func newIdempotentResults(tx *gorm.DB, requests []*ResultRequest) (*idempotentResults, error) {
+	ir := &idempotentResults{results: map[string]keyedResult{}}
+	var keys []string
+	seen := map[string]bool{}
+	for _, r := range requests {
+		if k := r.GetIdempotencyKey(); k != "" && !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return ir, nil
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?, hashtext(?))", idempotencyLockClass, k).Error; err != nil {
+			return nil, fmt.Errorf("failed to lock idempotency key %q: %w", k, err)
+		}
+	}
+
+	var results []models.Result
+	err := tx.Select("id", "idempotency_key", "request_hash").Where("idempotency_key IN ?", keys).Find(&results).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup results by idempotency key: %w", err)
+	}
+	for _, r := range results {
+		ir.results[r.IdempotencyKey.String] = keyedResult{ID: r.ID, RequestHash: r.RequestHash.String}
+	}
+	// Keys of results that were replaced later in an assessment are kept in
+	// their history.
+	var history []models.ResultHistory
+	err = tx.Select("result_id", "idempotency_key", "request_hash").Where("idempotency_key IN ?", keys).
+		Find(&history).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup result history by idempotency key: %w", err)
+	}
+	for _, h := range history {
+		if _, ok := ir.results[h.IdempotencyKey.String]; !ok {
+			ir.results[h.IdempotencyKey.String] = keyedResult{ID: h.ResultID, RequestHash: h.RequestHash.String}
+		}
+	}
+	return ir, nil
+}
+
+// replayed returns the ID of the result persisted for a request with the
+// same idempotency key, if there is one. Requests that reuse a key for a
+// different result are rejected.
+func (ir *idempotentResults) replayed(r *ResultRequest) (string, bool, error) {
+	k := r.GetIdempotencyKey()
+	if k == "" {
+		return "", false, nil
+	}
+	existing, ok := ir.results[k]
+	if !ok {
+		return "", false, nil
+	}
+	hash, err := requestHash(r)
+	if err != nil {
+		return "", false, err
+	}
+	if hash != existing.RequestHash {
+		return "", false, status.Errorf(codes.AlreadyExists,
+			"idempotency key %q was already used by result %s with a different request", k, existing.ID)
+	}
+	return existing.ID, true, nil
+}
+
+// add records a result that's about to be persisted so later requests in
+// the same batch with the same key are treated as retries.
+func (ir *idempotentResults) add(result *models.Result) {
+	if result.IdempotencyKey.Valid {
+		ir.results[result.IdempotencyKey.String] = keyedResult{ID: result.ID, RequestHash: result.RequestHash.String}
+	}
+}
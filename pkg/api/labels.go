@@ -0,0 +1,209 @@
+package compserv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// subjectLabelsCTE selects the labels of every subject, including the ones
+// it inherits. Labels are pushed down the hierarchy from the subjects that
+// set them, and the label closest to a subject wins, so a node's own env
+// label overrides the one on its cluster. It expects a maximum depth as an
+// argument.
+const subjectLabelsCTE = `WITH RECURSIVE inherited AS (
+	SELECT subject_id, key, value, 0 AS depth FROM subject_labels
+	UNION ALL
+	SELECT subjects.id, inherited.key, inherited.value, inherited.depth + 1 FROM inherited
+	JOIN subjects ON subjects.parent_id = inherited.subject_id
+	WHERE inherited.depth < ?
+), effective AS (
+	SELECT DISTINCT ON (subject_id, key) subject_id, key, value FROM inherited
+	ORDER BY subject_id, key, depth
+)`
+
+// integerPattern matches the label values that can be compared with the gt
+// and lt operators.
+const integerPattern = `^-?[0-9]+$`
+
+// parseLabelSelector parses a Kubernetes label selector, like
+// env=prod,team in (payments).
+func parseLabelSelector(field, s string) (labels.Selector, error) {
+	selector, err := labels.Parse(s)
+	if err != nil {
+		return nil, invalidField(field, "invalid label selector: %s", err)
+	}
+	return selector, nil
+}
+
+// labeledSubjects returns a subquery that selects the IDs of the subjects
+// matching a selector, by their own labels or the ones they inherit. Like
+// subjectSubtree, it's meant to be used as an argument to a query. The
+// selector should come from parseLabelSelector.
+func labeledSubjects(db *gorm.DB, selector labels.Selector) *gorm.DB {
+	requirements, _ := selector.Requirements()
+	var conditions []string
+	args := []interface{}{maxSubjectDepth}
+	for _, r := range requirements {
+		label := "SELECT 1 FROM effective WHERE effective.subject_id = subjects.id AND effective.key = ?"
+		values := r.Values().List()
+		switch r.Operator() {
+		case selection.Exists:
+			conditions = append(conditions, "EXISTS ("+label+")")
+			args = append(args, r.Key())
+		case selection.DoesNotExist:
+			conditions = append(conditions, "NOT EXISTS ("+label+")")
+			args = append(args, r.Key())
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			conditions = append(conditions, "EXISTS ("+label+" AND effective.value IN ?)")
+			args = append(args, r.Key(), values)
+		case selection.NotEquals, selection.NotIn:
+			// Like Kubernetes, subjects without the label match.
+			conditions = append(conditions, "NOT EXISTS ("+label+" AND effective.value IN ?)")
+			args = append(args, r.Key(), values)
+		case selection.GreaterThan, selection.LessThan:
+			op := ">"
+			if r.Operator() == selection.LessThan {
+				op = "<"
+			}
+			// The parser makes sure there's a single integer to
+			// compare with. Values that aren't integers never match.
+			n, _ := strconv.ParseInt(values[0], 10, 64)
+			conditions = append(conditions, "EXISTS ("+label+
+				" AND CASE WHEN effective.value ~ ? THEN effective.value::numeric "+op+" ? ELSE FALSE END)")
+			args = append(args, r.Key(), integerPattern, n)
+		}
+	}
+	if len(conditions) == 0 {
+		conditions = append(conditions, "TRUE")
+	}
+	return db.Raw(subjectLabelsCTE+" SELECT subjects.id FROM subjects WHERE "+strings.Join(conditions, " AND "),
+		args...)
+}
+
+func validateLabels(field string, l map[string]string) error {
+	keys := make([]string, 0, len(l))
+	for k := range l {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		f := fmt.Sprintf("%s[%s]", field, k)
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return invalidField(f, "invalid label key %q: %s", k, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(l[k]); len(errs) > 0 {
+			return invalidField(f, "invalid label value %q: %s", l[k], strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// loadSubjectLabels fills in the labels set on each subject with a single
+// query.
+func loadSubjectLabels(db *gorm.DB, subjects ...*Subject) error {
+	if len(subjects) == 0 {
+		return nil
+	}
+	byID := make(map[string][]*Subject, len(subjects))
+	ids := make([]string, 0, len(subjects))
+	for _, s := range subjects {
+		if _, ok := byID[s.Id]; !ok {
+			ids = append(ids, s.Id)
+		}
+		byID[s.Id] = append(byID[s.Id], s)
+	}
+	var rows []models.SubjectLabel
+	if err := db.Where("subject_id IN ?", ids).Find(&rows).Error; err != nil {
+		return fmt.Errorf("failed to lookup subject labels: %w", err)
+	}
+	for _, row := range rows {
+		for _, s := range byID[row.SubjectID] {
+			if s.Labels == nil {
+				s.Labels = map[string]string{}
+			}
+			s.Labels[row.Key] = row.Value
+		}
+	}
+	return nil
+}
+
+func (s *server) SetSubjectLabels(ctx context.Context, request *SetSubjectLabelsRequest) (*Subject, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	if len(request.GetLabels()) == 0 {
+		return nil, requiredField("labels")
+	}
+	if err := validateLabels("labels", request.GetLabels()); err != nil {
+		return nil, err
+	}
+
+	var subject *Subject
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		found, err := findSubject(tx, request.GetId())
+		if err != nil {
+			return err
+		}
+		rows := make([]models.SubjectLabel, 0, len(request.GetLabels()))
+		for k, v := range request.GetLabels() {
+			rows = append(rows, models.SubjectLabel{SubjectID: found.ID, Key: k, Value: v})
+		}
+		// Sorted so concurrent updates lock the rows in the same order.
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Key < rows[j].Key })
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subject_id"}, {Name: "key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value"}),
+		}).Create(&rows).Error
+		if err != nil {
+			return fmt.Errorf("failed to set labels of subject %s: %w", found.ID, err)
+		}
+		subject = toSubjectMessage(found)
+		return loadSubjectLabels(tx, subject)
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return subject, nil
+}
+
+func (s *server) RemoveSubjectLabels(ctx context.Context, request *RemoveSubjectLabelsRequest) (*Subject, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	if len(request.GetKeys()) == 0 {
+		return nil, requiredField("keys")
+	}
+	for i, k := range request.GetKeys() {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return nil, invalidField(fmt.Sprintf("keys[%d]", i), "invalid label key %q: %s", k, strings.Join(errs, "; "))
+		}
+	}
+
+	var subject *Subject
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		found, err := findSubject(tx, request.GetId())
+		if err != nil {
+			return err
+		}
+		err = tx.Where("subject_id = ? AND key IN ?", found.ID, request.GetKeys()).Delete(&models.SubjectLabel{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to remove labels of subject %s: %w", found.ID, err)
+		}
+		subject = toSubjectMessage(found)
+		return loadSubjectLabels(tx, subject)
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return subject, nil
+}
@@ -0,0 +1,136 @@
+package compserv
+
+import (
+	"context"
+	"time"
+
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"gorm.io/gorm"
+)
+
+// latestControlResults selects the latest result of every rule checked for
+// the controls with the given IDs on each subject. See
+// controlResultsCondition for the results that count towards a control.
+// DISTINCT ON keeps the first row of each subject and rule, so the ordering
+// determines which result is the latest. Results without metadata sort last
+// since we can't tell when they were reported. Failures also select the
+// exception covering them, if one is active at the given time.
+func latestControlResults(db, ids *gorm.DB, relationships []string, now time.Time) *gorm.DB {
+	match, args := controlResultsCondition(db, ids, relationships)
+	exception, exceptionArgs := activeException(now)
+	return selectResults(db).
+		Select("DISTINCT ON (results.subject_id, results.name) "+resultColumns+
+			", "+exception+" AS exception_id", exceptionArgs...).
+		Joins("LEFT JOIN assessments ON assessments.id = results.assessment_id").
+		Where(match, args...).
+		Where("results.subject_id IS NOT NULL").
+		Where("assessments.state IS DISTINCT FROM ?", assessmentAbandoned).
+		Order("results.subject_id, results.name, metadata.created_at DESC NULLS LAST, results.id DESC")
+}
+
+func (s *server) QueryControlPosture(ctx context.Context,
+	request *QueryControlPostureRequest,
+) (*QueryControlPostureResponse, error) {
+	if (request.GetControl() == "") == (request.GetFamily() == "") {
+		return nil, invalidField("control", "one of control or family is required")
+	}
+	if err := checkLength("control", request.GetControl(), maxNameLength); err != nil {
+		return nil, err
+	}
+	if err := checkLength("family", request.GetFamily(), maxNameLength); err != nil {
+		return nil, err
+	}
+	if err := checkLength("outcome", request.GetOutcome(), maxOutcomeLength); err != nil {
+		return nil, err
+	}
+	selector, err := parseLabelSelector("labelSelector", request.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+	if id := request.GetRootSubjectId(); id != "" {
+		if err := checkUUID("rootSubjectId", id); err != nil {
+			return nil, err
+		}
+	}
+	if id := request.GetCatalogId(); id != "" {
+		if err := checkUUID("catalogId", id); err != nil {
+			return nil, err
+		}
+	}
+	relationships, ok := crosswalkRelationships[request.GetCrosswalk()]
+	if !ok && request.GetCrosswalk() != Crosswalk_CROSSWALK_UNSPECIFIED {
+		return nil, invalidField("crosswalk", "unknown crosswalk %d", request.GetCrosswalk())
+	}
+	after, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	size := getPageSize(request.GetPageSize())
+
+	db := s.database.WithContext(ctx)
+	ids := matchingControls(db, request.GetControl(), request.GetCatalogId())
+	if request.GetFamily() != "" {
+		ids = familyControls(db, request.GetFamily(), request.GetCatalogId())
+	}
+	latest := latestControlResults(db, ids, relationships, time.Now().UTC())
+	if id := request.GetRootSubjectId(); id != "" {
+		if _, err := findSubject(db, id); err != nil {
+			return nil, toStatusError(err)
+		}
+		latest = latest.Where("results.subject_id IN (?)", subjectSubtree(db, id))
+	}
+	if request.GetLabelSelector() != "" {
+		latest = latest.Where("results.subject_id IN (?)", labeledSubjects(db, selector))
+	}
+	if after != "" {
+		latest = latest.Where("results.subject_id > ?", after)
+	}
+
+	// Page through subjects first, then fetch the latest results for the
+	// subjects on this page, so a page never splits a subject's results.
+	var subjectIDs []string
+	q := db.Table("(?) AS latest", latest).Distinct("subject_id")
+	if request.GetOutcome() != "" {
+		q = q.Where("CASE WHEN exception_id IS NULL THEN outcome ELSE ? END = ?", exceptedOutcome, request.GetOutcome())
+	}
+	if err := q.Order("subject_id").Limit(size+1).Pluck("subject_id", &subjectIDs).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+	response := &QueryControlPostureResponse{}
+	if len(subjectIDs) > size {
+		subjectIDs = subjectIDs[:size]
+		response.NextPageToken = encodePageToken(subjectIDs[size-1])
+	}
+	if len(subjectIDs) == 0 {
+		return response, nil
+	}
+
+	var subjects []models.Subject
+	if err := db.Where("id IN ?", subjectIDs).Order("id").Find(&subjects).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+	var rows []resultRow
+	err = db.Table("(?) AS latest", latest).Where("subject_id IN ?", subjectIDs).
+		Order("subject_id, name").Scan(&rows).Error
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	postures := make(map[string]*SubjectPosture, len(subjects))
+	messages := make([]*Subject, 0, len(subjects))
+	for i := range subjects {
+		p := &SubjectPosture{Subject: toSubjectMessage(&subjects[i])}
+		postures[subjects[i].ID] = p
+		response.Subjects = append(response.Subjects, p)
+		messages = append(messages, p.Subject)
+	}
+	if err := loadSubjectLabels(db, messages...); err != nil {
+		return nil, toStatusError(err)
+	}
+	for i := range rows {
+		if p, ok := postures[rows[i].SubjectID.String]; ok {
+			p.Results = append(p.Results, toResultMessage(&rows[i]))
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,317 @@
+package compserv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	oscal "github.com/rhmdnd/compserv/pkg/oscal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// resolvedImport is a profile import along with the stored catalog it
+// resolved to and the catalog controls it selects.
+type resolvedImport struct {
+	href      string
+	catalogID string
+	controls  []oscal.FlatControl
+	// catalog holds every control of the catalog.
+	catalog []oscal.FlatControl
+}
+
+func (s *server) ImportProfile(ctx context.Context, request *ImportProfileRequest) (*ImportProfileResponse, error) {
+	p, err := oscal.ParseProfile(request.GetContent())
+	if err != nil {
+		return nil, invalidField("content", "%s", err)
+	}
+	if len(p.Metadata.Title) > maxNameLength {
+		return nil, invalidField("content", "profile title must be %d characters or less", maxNameLength)
+	}
+	if len(p.Metadata.Version) > maxVersionLength {
+		return nil, invalidField("content", "profile version must be %d characters or less", maxVersionLength)
+	}
+	for href, id := range request.GetCatalogIds() {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, invalidField("catalogIds", "catalog ID %q for %s is not a valid UUID", id, href)
+		}
+	}
+	parameters, err := json.Marshal(p.Parameters())
+	if err != nil {
+		return nil, invalidField("content", "failed to encode profile parameters: %s", err)
+	}
+
+	var response *ImportProfileResponse
+	err = s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		imports, err := resolveProfileImports(tx, p, request.GetCatalogIds())
+		if err != nil {
+			return err
+		}
+		profile, created, err := findOrCreateProfile(tx, p, request.GetContent(), string(parameters), imports[0].catalogID)
+		if err != nil {
+			return err
+		}
+		if err := replaceProfileCatalogs(tx, profile.ID, imports); err != nil {
+			return err
+		}
+		controls, err := upsertProfileControls(tx, profile.ID, imports)
+		if err != nil {
+			return err
+		}
+		response = &ImportProfileResponse{
+			ProfileId: profile.ID,
+			Name:      profile.Name,
+			Version:   profile.Version.String,
+			Controls:  controls,
+			Created:   created,
+		}
+		for _, i := range imports {
+			response.CatalogIds = append(response.CatalogIds, i.catalogID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return response, nil
+}
+
+// resolveProfileImports matches each import of the profile to a stored
+// catalog and selects the controls the import asks for. Profiles reference
+// catalogs by URL, which we can't fetch, so the client tells us which stored
+// catalog satisfies each import.
+func resolveProfileImports(tx *gorm.DB, p *oscal.Profile, catalogIDs map[string]string) ([]resolvedImport, error) {
+	imports := make([]resolvedImport, 0, len(p.Imports))
+	seen := map[string]bool{}
+	for i := range p.Imports {
+		imp := &p.Imports[i]
+		catalogID := ""
+		for _, href := range p.ImportHrefs(imp) {
+			if id, ok := catalogIDs[href]; ok {
+				catalogID = id
+				break
+			}
+		}
+		if catalogID == "" {
+			return nil, invalidField("catalogIds", "no catalog ID provided for import %s", imp.Href)
+		}
+		if seen[catalogID] {
+			return nil, invalidField("catalogIds", "catalog %s is imported more than once", catalogID)
+		}
+		seen[catalogID] = true
+
+		catalog := &models.Catalog{}
+		err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Where("id = ?", catalogID).Take(catalog).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalidField("catalogIds", "catalog %s does not exist", catalogID)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to lookup catalog %s: %w", catalogID, err)
+		}
+		c, err := oscal.ParseCatalog([]byte(catalog.Content.String))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stored catalog %s: %w", catalogID, err)
+		}
+		catalogControls := c.Flatten()
+		controls, err := imp.Select(catalogControls)
+		if err != nil {
+			return nil, invalidField("content", "failed to resolve import %s: %s", imp.Href, err)
+		}
+		imports = append(imports, resolvedImport{
+			href:      imp.Href,
+			catalogID: catalogID,
+			controls:  controls,
+			catalog:   catalogControls,
+		})
+	}
+	return imports, nil
+}
+
+// findOrCreateProfile stores the unresolved profile document. Like catalogs,
+// profiles are unique by name and version and importing a profile again
+// replaces the content of the existing profile.
+func findOrCreateProfile(tx *gorm.DB, p *oscal.Profile, content []byte,
+	parameters, catalogID string,
+) (*models.Profile, bool, error) {
+	profile := &models.Profile{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("name = ? AND version = ?", p.Metadata.Title, p.Metadata.Version).Take(profile).Error
+	if err == nil {
+		profile.Content = toNullString(string(content))
+		profile.Parameters = toNullString(parameters)
+		profile.CatalogID = toNullString(catalogID)
+		if err := tx.Save(profile).Error; err != nil {
+			return nil, false, fmt.Errorf("failed to update profile %s: %w", profile.ID, err)
+		}
+		return profile, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, fmt.Errorf("failed to lookup profile %s: %w", p.Metadata.Title, err)
+	}
+
+	now := time.Now().UTC()
+	md := &models.Metadata{
+		ID:        uuid.NewString(),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   toNullString(p.Metadata.Version),
+	}
+	if err := tx.Create(md).Error; err != nil {
+		return nil, false, fmt.Errorf("failed to create metadata: %w", err)
+	}
+	profile = &models.Profile{
+		ID:         uuid.NewString(),
+		Name:       p.Metadata.Title,
+		MetadataID: toNullString(md.ID),
+		CatalogID:  toNullString(catalogID),
+		Version:    toNullString(p.Metadata.Version),
+		Content:    toNullString(string(content)),
+		Parameters: toNullString(parameters),
+	}
+	if err := tx.Create(profile).Error; err != nil {
+		return nil, false, fmt.Errorf("failed to create profile %s: %w", p.Metadata.Title, err)
+	}
+	return profile, true, nil
+}
+
+// replaceProfileCatalogs records every catalog the profile imports. The
+// profiles.catalog_id column only holds the first one.
+func replaceProfileCatalogs(tx *gorm.DB, profileID string, imports []resolvedImport) error {
+	if err := tx.Where("profile_id = ?", profileID).Delete(&models.ProfileCatalog{}).Error; err != nil {
+		return fmt.Errorf("failed to delete catalogs of profile %s: %w", profileID, err)
+	}
+	rows := make([]models.ProfileCatalog, 0, len(imports))
+	for _, i := range imports {
+		rows = append(rows, models.ProfileCatalog{
+			ProfileID: profileID,
+			CatalogID: i.catalogID,
+			Href:      toNullString(i.href),
+		})
+	}
+	if err := tx.Create(rows).Error; err != nil {
+		return fmt.Errorf("failed to create catalogs of profile %s: %w", profileID, err)
+	}
+	return nil
+}
+
+// controlReference is a column that references controls. key lists the
+// other columns of its unique key, if it has one.
+type controlReference struct {
+	table  string
+	column string
+	key    []string
+}
+
+// controlReferences lists everything that references controls.
+var controlReferences = []controlReference{
+	{table: "results", column: "control_id"},
+	{table: "result_history", column: "control_id"},
+	{table: "rule_controls", column: "control_id", key: []string{"rule_id"}},
+	{table: "daily_compliance_rollups", column: "control_id", key: []string{"day", "subject_id", "outcome"}},
+	{table: "control_mappings", column: "source_control_id", key: []string{"target_control_id"}},
+	{table: "control_mappings", column: "target_control_id", key: []string{"source_control_id"}},
+	{table: "exceptions", column: "control_id"},
+}
+
+// catalogOriginals joins profile controls to the catalog controls they were
+// copied from.
+const catalogOriginals = "controls AS copies JOIN controls AS originals ON originals.profile_id IS NULL " +
+	"AND originals.catalog_id = copies.catalog_id AND originals.oscal_id = copies.oscal_id"
+
+// moveControlReferences points whatever references the given profile
+// controls at the catalog controls they were copied from. References that
+// would duplicate one the catalog control already has are dropped, and the
+// rollups of the affected results are recalculated.
+func moveControlReferences(tx *gorm.DB, copies *gorm.DB) error {
+	moved := tx.Model(&models.Result{}).Select("id").Where("control_id IN (?)", copies)
+	if err := markRollupsStale(tx, moved); err != nil {
+		return err
+	}
+	for _, r := range controlReferences {
+		if len(r.key) > 0 {
+			same := make([]string, 0, len(r.key))
+			for _, k := range r.key {
+				same = append(same, fmt.Sprintf("other.%s = %s.%s", k, r.table, k))
+			}
+			err := tx.Exec(fmt.Sprintf("DELETE FROM %s USING %s WHERE %s.%s = copies.id AND copies.id IN (?) "+
+				"AND EXISTS (SELECT 1 FROM %s AS other WHERE other.%s = originals.id AND %s)",
+				r.table, catalogOriginals, r.table, r.column, r.table, r.column, strings.Join(same, " AND ")),
+				copies).Error
+			if err != nil {
+				return fmt.Errorf("failed to delete duplicate references from %s: %w", r.table, err)
+			}
+		}
+		err := tx.Exec(fmt.Sprintf("UPDATE %s SET %s = originals.id FROM %s WHERE %s.%s = copies.id AND copies.id IN (?)",
+			r.table, r.column, catalogOriginals, r.table, r.column), copies).Error
+		if err != nil {
+			return fmt.Errorf("failed to move references from %s: %w", r.table, err)
+		}
+	}
+	return nil
+}
+
+// upsertProfileControls stores the resolved baseline as controls that belong
+// to the profile, and removes the controls it no longer selects once their
+// references are moved to the catalog. It returns the number of controls the
+// profile selects.
+func upsertProfileControls(tx *gorm.DB, profileID string, imports []resolvedImport) (int64, error) {
+	unselected := func() *gorm.DB {
+		q := tx.Model(&models.Control{}).Where("profile_id = ?", profileID)
+		for _, i := range imports {
+			ids := make([]string, 0, len(i.controls))
+			for _, ctl := range i.controls {
+				ids = append(ids, ctl.ID)
+			}
+			if len(ids) == 0 {
+				continue
+			}
+			q = q.Where("NOT (catalog_id = ? AND oscal_id IN ?)", i.catalogID, ids)
+		}
+		return q
+	}
+	if err := moveControlReferences(tx, unselected().Select("id")); err != nil {
+		return 0, err
+	}
+	// Controls whose catalog control is gone keep their references, and
+	// stay with the profile.
+	q := unselected()
+	for _, r := range controlReferences {
+		q = q.Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s.%s = controls.id)", r.table, r.table, r.column))
+	}
+	if err := q.Delete(&models.Control{}).Error; err != nil {
+		return 0, fmt.Errorf("failed to delete stale controls for profile %s: %w", profileID, err)
+	}
+
+	var rows []models.Control
+	for _, i := range imports {
+		if len(i.controls) == 0 {
+			continue
+		}
+		families, err := upsertControlFamilies(tx, i.catalogID, i.catalog)
+		if err != nil {
+			return 0, err
+		}
+		existing, err := controlIDs(tx.Where("profile_id = ? AND catalog_id = ?", profileID, i.catalogID))
+		if err != nil {
+			return 0, err
+		}
+		base := models.Control{ProfileID: toNullString(profileID), CatalogID: toNullString(i.catalogID)}
+		rows = append(rows, controlRows(base, i.controls, existing, families)...)
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "profile_id"}, {Name: "catalog_id"}, {Name: "oscal_id"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "profile_id IS NOT NULL"}}},
+		DoUpdates:   clause.AssignmentColumns(controlImportColumns),
+	}).CreateInBatches(rows, controlBatchSize).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to create controls for profile %s: %w", profileID, err)
+	}
+	return int64(len(rows)), nil
+}
@@ -0,0 +1,193 @@
+package compserv
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// resultRow is a result joined with the subject, control, and metadata it
+// references, which is what we need to build a Result message.
+type resultRow struct {
+	ID           string
+	Name         string
+	Outcome      string
+	Instruction  sql.NullString
+	Rationale    sql.NullString
+	SubjectID    sql.NullString
+	Subject      sql.NullString
+	ControlID    sql.NullString
+	Control      sql.NullString
+	Severity     sql.NullString
+	AssessmentID sql.NullString
+	Extra        sql.NullString
+	CreatedAt    sql.NullTime
+	// ExceptionID is only selected by queries that report excepted
+	// failures, see activeException.
+	ExceptionID sql.NullString
+}
+
+// resultColumns are the columns selectResults reads into a resultRow.
+const resultColumns = "results.id, results.name, results.outcome, results.instruction, results.rationale, " +
+	"results.subject_id, subjects.name AS subject, " +
+	"results.control_id, controls.name AS control, controls.severity, " +
+	"results.assessment_id, metadata.description AS extra, metadata.created_at"
+
+func selectResults(db *gorm.DB) *gorm.DB {
+	return db.Table("results").
+		Select(resultColumns).
+		Joins("LEFT JOIN subjects ON subjects.id = results.subject_id").
+		Joins("LEFT JOIN controls ON controls.id = results.control_id").
+		Joins("LEFT JOIN metadata ON metadata.id = results.metadata_id")
+}
+
+func validateResultFilter(f *ResultFilter) error {
+	if id := f.GetSubjectId(); id != "" {
+		if err := checkUUID("filter.subjectId", id); err != nil {
+			return err
+		}
+	}
+	if id := f.GetAssessmentId(); id != "" {
+		if err := checkUUID("filter.assessmentId", id); err != nil {
+			return err
+		}
+	}
+	if f.GetControl() != "" && f.GetFamily() != "" {
+		return invalidField("filter.family", "only one of control or family can be provided")
+	}
+	if _, err := parseLabelSelector("filter.labelSelector", f.GetLabelSelector()); err != nil {
+		return err
+	}
+	if c := f.GetCrosswalk(); c != Crosswalk_CROSSWALK_UNSPECIFIED {
+		if _, ok := crosswalkRelationships[c]; !ok {
+			return invalidField("filter.crosswalk", "unknown crosswalk %d", c)
+		}
+		if f.GetControl() == "" && f.GetFamily() == "" {
+			return invalidField("filter.crosswalk", "crosswalk requires a control or family")
+		}
+	}
+	if id := f.GetCatalogId(); id != "" {
+		if err := checkUUID("filter.catalogId", id); err != nil {
+			return err
+		}
+		if f.GetControl() == "" && f.GetFamily() == "" {
+			return invalidField("filter.catalogId", "catalogId requires a control or family")
+		}
+	}
+	return nil
+}
+
+// filterResults applies a filter to a query built with selectResults. The
+// filter must be validated first.
+func filterResults(q *gorm.DB, f *ResultFilter) *gorm.DB {
+	if f.GetSubjectId() != "" {
+		q = q.Where("results.subject_id = ?", f.GetSubjectId())
+	}
+	if f.GetSubject() != "" {
+		q = q.Where("subjects.name = ?", f.GetSubject())
+	}
+	if f.GetControl() != "" || f.GetFamily() != "" {
+		db := q.Session(&gorm.Session{NewDB: true})
+		ids := matchingControls(db, f.GetControl(), f.GetCatalogId())
+		if f.GetFamily() != "" {
+			ids = familyControls(db, f.GetFamily(), f.GetCatalogId())
+		}
+		match, args := controlResultsCondition(db, ids, crosswalkRelationships[f.GetCrosswalk()])
+		q = q.Where(match, args...)
+	}
+	if f.GetAssessmentId() != "" {
+		q = q.Where("results.assessment_id = ?", f.GetAssessmentId())
+	}
+	if f.GetOutcome() != "" {
+		q = q.Where("results.outcome = ?", f.GetOutcome())
+	}
+	if f.GetSeverity() != "" {
+		q = q.Where("controls.severity = ?", f.GetSeverity())
+	}
+	if f.GetLabelSelector() != "" {
+		selector, _ := parseLabelSelector("filter.labelSelector", f.GetLabelSelector())
+		q = q.Where("results.subject_id IN (?)", labeledSubjects(q.Session(&gorm.Session{NewDB: true}), selector))
+	}
+	return q
+}
+
+func toResultMessage(row *resultRow) *Result {
+	r := &Result{
+		Id:           row.ID,
+		SubjectId:    row.SubjectID.String,
+		Subject:      row.Subject.String,
+		ControlId:    row.ControlID.String,
+		Control:      row.Control.String,
+		Rule:         row.Name,
+		AssessmentId: row.AssessmentID.String,
+		Outcome:      row.Outcome,
+		Description:  row.Rationale.String,
+		Severity:     row.Severity.String,
+		Instructions: row.Instruction.String,
+	}
+	if row.CreatedAt.Valid {
+		r.CreatedAt = timestamppb.New(row.CreatedAt.Time)
+	}
+	if row.ExceptionID.Valid {
+		r.Outcome = exceptedOutcome
+		r.ExceptionId = row.ExceptionID.String
+	}
+	if row.Extra.Valid {
+		if err := json.Unmarshal([]byte(row.Extra.String), &r.Extra); err != nil {
+			log.Printf("Ignoring malformed extra information for result %s: %s", row.ID, err)
+		}
+	}
+	return r
+}
+
+// pageToken is the decoded form of the page tokens we give to clients.
+// Results are paged using the last ID of the previous page so we can seek
+// directly to the next page using the primary key index, instead of using
+// OFFSET, which gets slower the further you page.
+type pageToken struct {
+	After string `json:"after"`
+}
+
+func encodePageToken(after string) string {
+	b, _ := json.Marshal(pageToken{After: after})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return "", invalidField("pageToken", "invalid page token")
+	}
+	t := pageToken{}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return "", invalidField("pageToken", "invalid page token")
+	}
+	if _, err := uuid.Parse(t.After); err != nil {
+		return "", invalidField("pageToken", "invalid page token")
+	}
+	return t.After, nil
+}
+
+func getPageSize(size int32) int {
+	switch {
+	case size <= 0:
+		return defaultPageSize
+	case size > maxPageSize:
+		return maxPageSize
+	default:
+		return int(size)
+	}
+}
@@ -0,0 +1,254 @@
+package compserv
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// exportChunkSize is the size a chunk of an export grows to before it's
+// sent to the client.
+const exportChunkSize = 64 * 1024
+
+// exportColumn is a column ExportResults can write, along with the
+// expression selecting it from a query built with selectResults.
+type exportColumn struct {
+	name string
+	expr string
+	// Timestamps are written in RFC 3339 format in UTC.
+	timestamp bool
+}
+
+// exportColumns are the columns ExportResults can write, in the order they
+// are written by default.
+var exportColumns = []exportColumn{
+	{name: "id", expr: "results.id"},
+	{name: "rule", expr: "results.name"},
+	{name: "outcome", expr: "results.outcome"},
+	{name: "description", expr: "results.rationale"},
+	{name: "instructions", expr: "results.instruction"},
+	{name: "createdAt", expr: "metadata.created_at", timestamp: true},
+	{name: "subjectId", expr: "results.subject_id"},
+	{name: "subject", expr: "subjects.name"},
+	{name: "subjectType", expr: "subjects.type"},
+	{name: "subjectParentId", expr: "subjects.parent_id"},
+	{name: "controlId", expr: "results.control_id"},
+	{name: "control", expr: "controls.name"},
+	{name: "controlTitle", expr: "controls.title"},
+	{name: "severity", expr: "controls.severity"},
+	{name: "assessmentId", expr: "results.assessment_id"},
+	{name: "assessment", expr: "assessments.name"},
+	{name: "assessmentState", expr: "assessments.state"},
+	{name: "assessmentStartedAt", expr: "assessments.started_at", timestamp: true},
+	{name: "assessmentFinishedAt", expr: "assessments.finished_at", timestamp: true},
+}
+
+// exportContentTypes are the media types of the export formats.
+var exportContentTypes = map[ExportFormat]string{
+	ExportFormat_EXPORT_FORMAT_CSV:   "text/csv; charset=utf-8",
+	ExportFormat_EXPORT_FORMAT_JSONL: "application/jsonl",
+}
+
+// exportFileExtensions are the file extensions of the export formats.
+var exportFileExtensions = map[ExportFormat]string{
+	ExportFormat_EXPORT_FORMAT_CSV:   "csv",
+	ExportFormat_EXPORT_FORMAT_JSONL: "jsonl",
+}
+
+// getExportFormat returns the format of an export, which defaults to CSV.
+func getExportFormat(format ExportFormat) (ExportFormat, error) {
+	if format == ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return ExportFormat_EXPORT_FORMAT_CSV, nil
+	}
+	if _, ok := exportContentTypes[format]; !ok {
+		return format, invalidField("format", "unknown export format %d", format)
+	}
+	return format, nil
+}
+
+// getExportColumns looks up the columns requested for an export.
+func getExportColumns(names []string) ([]exportColumn, error) {
+	if len(names) == 0 {
+		return exportColumns, nil
+	}
+	columns := make([]exportColumn, 0, len(names))
+	for i, name := range names {
+		found := false
+		for _, c := range exportColumns {
+			if c.name == name {
+				columns = append(columns, c)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, invalidField(fmt.Sprintf("columns[%d]", i), "unknown column %q", name)
+		}
+	}
+	return columns, nil
+}
+
+// exportScanner reads the columns of a row into strings, so every format
+// can treat them alike.
+type exportScanner struct {
+	columns []exportColumn
+	dest    []interface{}
+	values  []sql.NullString
+	times   []sql.NullTime
+}
+
+func newExportScanner(columns []exportColumn) *exportScanner {
+	s := &exportScanner{
+		columns: columns,
+		dest:    make([]interface{}, len(columns)),
+		values:  make([]sql.NullString, len(columns)),
+		times:   make([]sql.NullTime, len(columns)),
+	}
+	for i, c := range columns {
+		if c.timestamp {
+			s.dest[i] = &s.times[i]
+		} else {
+			s.dest[i] = &s.values[i]
+		}
+	}
+	return s
+}
+
+func (s *exportScanner) scan(rows *sql.Rows) ([]sql.NullString, error) {
+	if err := rows.Scan(s.dest...); err != nil {
+		return nil, err
+	}
+	for i, c := range s.columns {
+		if !c.timestamp {
+			continue
+		}
+		t := s.times[i]
+		s.values[i] = sql.NullString{String: t.Time.UTC().Format(time.RFC3339Nano), Valid: t.Valid}
+	}
+	return s.values, nil
+}
+
+// exportEncoder writes a row of an export to a buffer.
+type exportEncoder func(values []sql.NullString) error
+
+// newExportEncoder returns an encoder for a format. CSV exports start with
+// a header row, which is written to the buffer right away.
+func newExportEncoder(format ExportFormat, buf *bytes.Buffer, columns []exportColumn) (exportEncoder, error) {
+	if format == ExportFormat_EXPORT_FORMAT_JSONL {
+		keys := make([][]byte, len(columns))
+		for i, c := range columns {
+			keys[i], _ = json.Marshal(c.name)
+		}
+		return func(values []sql.NullString) error {
+			buf.WriteByte('{')
+			for i, v := range values {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				buf.Write(keys[i])
+				buf.WriteByte(':')
+				if !v.Valid {
+					buf.WriteString("null")
+					continue
+				}
+				b, err := json.Marshal(v.String)
+				if err != nil {
+					return err
+				}
+				buf.Write(b)
+			}
+			buf.WriteString("}\n")
+			return nil
+		}, nil
+	}
+
+	w := csv.NewWriter(buf)
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.name
+	}
+	record := make([]string, len(columns))
+	write := func(r []string) error {
+		if err := w.Write(r); err != nil {
+			return err
+		}
+		w.Flush()
+		return w.Error()
+	}
+	return func(values []sql.NullString) error {
+		for i, v := range values {
+			record[i] = v.String
+		}
+		return write(record)
+	}, write(header)
+}
+
+func (s *server) ExportResults(request *ExportResultsRequest, stream ComplianceService_ExportResultsServer) error {
+	if err := validateResultFilter(request.GetFilter()); err != nil {
+		return err
+	}
+	format, err := getExportFormat(request.GetFormat())
+	if err != nil {
+		return err
+	}
+	columns, err := getExportColumns(request.GetColumns())
+	if err != nil {
+		return err
+	}
+	exprs := make([]string, len(columns))
+	for i, c := range columns {
+		exprs[i] = c.expr
+	}
+
+	// Rows are read as they're sent instead of all at once, so the
+	// database connection is held until the export is done.
+	q := filterResults(selectResults(s.database.WithContext(stream.Context())), request.GetFilter()).
+		Joins("LEFT JOIN assessments ON assessments.id = results.assessment_id").
+		Select(strings.Join(exprs, ", ")).
+		Order("results.id")
+	rows, err := q.Rows()
+	if err != nil {
+		return toStatusError(fmt.Errorf("failed to query results: %w", err))
+	}
+	defer rows.Close()
+
+	buf := &bytes.Buffer{}
+	encode, err := newExportEncoder(format, buf, columns)
+	if err != nil {
+		return toStatusError(fmt.Errorf("failed to encode header: %w", err))
+	}
+	// Messages can't be modified once they're sent, so each chunk gets a
+	// copy of the buffer.
+	send := func() error {
+		err := stream.Send(&ExportResultsResponse{Data: append([]byte(nil), buf.Bytes()...)})
+		buf.Reset()
+		return err
+	}
+	scanner := newExportScanner(columns)
+	for rows.Next() {
+		values, err := scanner.scan(rows)
+		if err != nil {
+			return toStatusError(fmt.Errorf("failed to read result: %w", err))
+		}
+		if err := encode(values); err != nil {
+			return toStatusError(fmt.Errorf("failed to encode result: %w", err))
+		}
+		if buf.Len() < exportChunkSize {
+			continue
+		}
+		if err := send(); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return toStatusError(fmt.Errorf("failed to read results: %w", err))
+	}
+	if buf.Len() > 0 {
+		return send()
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package compserv
+
+import (
+	"bytes"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportEncoders(t *testing.T) {
+	t.Parallel()
+	columns, err := getExportColumns([]string{"subject", "rule", "outcome", "description"})
+	if err != nil {
+		t.Fatalf("Unable to get export columns: %s", err)
+	}
+	rows := [][]sql.NullString{
+		{toNullString("node-1"), toNullString("rule-1"), toNullString("PASS"), toNullString(`quoted "value", with comma`)},
+		{toNullString("node-2"), toNullString("rule-2"), toNullString("FAIL"), {}},
+	}
+	tests := []struct {
+		format   ExportFormat
+		expected string
+	}{
+		{
+			ExportFormat_EXPORT_FORMAT_CSV,
+			"subject,rule,outcome,description\n" +
+				"node-1,rule-1,PASS,\"quoted \"\"value\"\", with comma\"\n" +
+				"node-2,rule-2,FAIL,\n",
+		},
+		{
+			ExportFormat_EXPORT_FORMAT_JSONL,
+			`{"subject":"node-1","rule":"rule-1","outcome":"PASS","description":"quoted \"value\", with comma"}` + "\n" +
+				`{"subject":"node-2","rule":"rule-2","outcome":"FAIL","description":null}` + "\n",
+		},
+	}
+	for _, tc := range tests {
+		buf := &bytes.Buffer{}
+		encode, err := newExportEncoder(tc.format, buf, columns)
+		if err != nil {
+			t.Fatalf("Unable to create %s encoder: %s", tc.format, err)
+		}
+		for _, row := range rows {
+			if err := encode(row); err != nil {
+				t.Fatalf("Unable to encode row: %s", err)
+			}
+		}
+		assert.Equal(t, tc.expected, buf.String(), "expected %s got %s", tc.expected, buf.String())
+	}
+
+	_, err = getExportColumns([]string{"subject", "password"})
+	assert.Equal(t, "columns[1]", violationField(status.Convert(err)))
+}
@@ -0,0 +1,336 @@
+package compserv
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// These limits reflect the column sizes defined in the migrations. Checking
+// them before we touch the database lets us return a meaningful error to the
+// client instead of a generic database error.
+const (
+	maxNameLength     = 255
+	maxSeverityLength = 50
+	maxOutcomeLength  = 255
+	maxVersionLength  = 50
+)
+
+func validateResultRequest(r *ResultRequest) error {
+	err := firstError(
+		checkRequired("subject", r.GetSubject()),
+		checkRequired("control", r.GetControl()),
+		checkRequired("rule", r.GetRule()),
+		checkRequired("outcome", r.GetOutcome()),
+		checkLength("subject", r.GetSubject(), maxNameLength),
+		checkLength("control", r.GetControl(), maxNameLength),
+		checkLength("rule", r.GetRule(), maxNameLength),
+		checkLength("outcome", r.GetOutcome(), maxOutcomeLength),
+		checkLength("severity", r.GetSeverity(), maxSeverityLength),
+		checkLength("idempotencyKey", r.GetIdempotencyKey(), maxNameLength),
+	)
+	if err != nil {
+		return err
+	}
+	if id := r.GetAssessmentId(); id != "" {
+		return checkUUID("assessmentId", id)
+	}
+	return nil
+}
+
+// persistResult writes a single result, and any subject or control it
+// references that doesn't exist yet, using the provided transaction. The
+// request must be validated before it's passed to this function. Retries of
+// a request with an idempotency key return the original result's ID, and
+// results reported again during the same assessment replace the original.
+func persistResult(tx *gorm.DB, r *ResultRequest) (string, error) {
+	ir, err := newIdempotentResults(tx, []*ResultRequest{r})
+	if err != nil {
+		return "", err
+	}
+	if id, ok, err := ir.replayed(r); ok || err != nil {
+		return id, err
+	}
+	result, md, err := newResultResolver(tx).resolve(r)
+	if err != nil {
+		return "", err
+	}
+	if err := saveResults(tx, []*models.Result{result}, []*models.Metadata{md}); err != nil {
+		return "", err
+	}
+	return result.ID, nil
+}
+
+// resultBatchSize is the number of results written to the database in a
+// single transaction when results are streamed to the service.
+const resultBatchSize = 500
+
+// resultBatch buffers streamed results so they can be written with a single
+// transaction and bulk inserts instead of one transaction per result.
+type resultBatch struct {
+	db       *gorm.DB
+	response *SetResultsResponse
+	indexes  []int64
+	requests []*ResultRequest
+}
+
+func newResultBatch(db *gorm.DB, response *SetResultsResponse) *resultBatch {
+	return &resultBatch{db: db, response: response}
+}
+
+func (b *resultBatch) add(index int64, r *ResultRequest) {
+	b.indexes = append(b.indexes, index)
+	b.requests = append(b.requests, r)
+}
+
+func (b *resultBatch) full() bool {
+	return len(b.requests) >= resultBatchSize
+}
+
+// flush persists the buffered results and records the outcome of each one in
+// the response. Results that fail to resolve, like those referencing an
+// assessment that doesn't exist, are rejected individually. Unexpected
+// database errors reject the entire batch since the transaction is rolled
+// back. Retries of results that were already persisted are accepted without
+// persisting them again.
+func (b *resultBatch) flush() {
+	if len(b.requests) == 0 {
+		return
+	}
+	var rejected []int64
+	var rejectedErrs []error
+	accepted := int64(0)
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		ir, err := newIdempotentResults(tx, b.requests)
+		if err != nil {
+			return err
+		}
+		rr := newResultResolver(tx)
+		results := make([]*models.Result, 0, len(b.requests))
+		mds := make([]*models.Metadata, 0, len(b.requests))
+		for i, r := range b.requests {
+			_, replay, err := ir.replayed(r)
+			var result *models.Result
+			var md *models.Metadata
+			if err == nil && !replay {
+				result, md, err = rr.resolve(r)
+			}
+			if err != nil {
+				// Errors with a status are problems with the
+				// request itself and only affect that result.
+				if _, ok := status.FromError(err); !ok {
+					return err
+				}
+				rejected = append(rejected, b.indexes[i])
+				rejectedErrs = append(rejectedErrs, err)
+				continue
+			}
+			if replay {
+				accepted++
+				continue
+			}
+			ir.add(result)
+			results = append(results, result)
+			mds = append(mds, md)
+		}
+		if len(results) == 0 {
+			return nil
+		}
+		if err := saveResults(tx, results, mds); err != nil {
+			return err
+		}
+		accepted += int64(len(results))
+		return nil
+	})
+	if err != nil {
+		err = toStatusError(err)
+		for _, index := range b.indexes {
+			rejectResult(b.response, index, err)
+		}
+	} else {
+		for i, index := range rejected {
+			rejectResult(b.response, index, rejectedErrs[i])
+		}
+		b.response.Accepted += accepted
+	}
+	b.indexes = b.indexes[:0]
+	b.requests = b.requests[:0]
+}
+
+func rejectResult(response *SetResultsResponse, index int64, err error) {
+	st := status.Convert(err)
+	response.Rejected++
+	response.Errors = append(response.Errors, &ResultError{
+		Index: index, Code: int32(st.Code()), Message: st.Message(), Field: violationField(st),
+	})
+}
+
+// resultResolver turns result requests into rows, looking up or creating the
+// subjects, controls and rules they reference. It caches the IDs it finds so
+// batches of results for the same subject don't query the database for
+// every result.
+type resultResolver struct {
+	tx          *gorm.DB
+	subjects    map[string]string
+	controls    map[string]string
+	rules       map[string]string
+	assessments map[string]string
+	// linked holds the rule and control pairs known to be mapped.
+	linked map[[2]string]bool
+}
+
+func newResultResolver(tx *gorm.DB) *resultResolver {
+	return &resultResolver{
+		tx:          tx,
+		subjects:    map[string]string{},
+		controls:    map[string]string{},
+		rules:       map[string]string{},
+		assessments: map[string]string{},
+		linked:      map[[2]string]bool{},
+	}
+}
+
+// resolve returns the result and metadata rows for a request without
+// persisting them. Subjects, controls and rules are created as needed, and
+// the rule is mapped to the control it was reported for.
+func (rr *resultResolver) resolve(r *ResultRequest) (*models.Result, *models.Metadata, error) {
+	subjectID, ok := rr.subjects[r.GetSubject()]
+	if !ok {
+		var err error
+		if subjectID, err = findOrCreateSubject(rr.tx, r.GetSubject()); err != nil {
+			return nil, nil, err
+		}
+		rr.subjects[r.GetSubject()] = subjectID
+	}
+	controlID, ok := rr.controls[r.GetControl()]
+	if !ok {
+		var err error
+		if controlID, err = findOrCreateControl(rr.tx, r.GetControl(), r.GetSeverity()); err != nil {
+			return nil, nil, err
+		}
+		rr.controls[r.GetControl()] = controlID
+	}
+	ruleID, ok := rr.rules[r.GetRule()]
+	if !ok {
+		var err error
+		if ruleID, err = findOrCreateRule(rr.tx, r.GetRule(), r.GetSeverity()); err != nil {
+			return nil, nil, err
+		}
+		rr.rules[r.GetRule()] = ruleID
+	}
+	if pair := [2]string{ruleID, controlID}; !rr.linked[pair] {
+		if err := linkRuleControl(rr.tx, ruleID, controlID); err != nil {
+			return nil, nil, err
+		}
+		rr.linked[pair] = true
+	}
+	assessmentID, ok := rr.assessments[r.GetAssessmentId()]
+	if !ok {
+		var err error
+		if assessmentID, err = findAssessment(rr.tx, r.GetAssessmentId()); err != nil {
+			return nil, nil, err
+		}
+		rr.assessments[r.GetAssessmentId()] = assessmentID
+	}
+	md, err := newMetadata(r.GetExtra())
+	if err != nil {
+		return nil, nil, err
+	}
+	hash := ""
+	if r.GetIdempotencyKey() != "" {
+		if hash, err = requestHash(r); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	result := &models.Result{
+		ID:             uuid.NewString(),
+		Name:           r.GetRule(),
+		Outcome:        r.GetOutcome(),
+		Instruction:    toNullString(r.GetInstructions()),
+		Rationale:      toNullString(r.GetDescription()),
+		ControlID:      toNullString(controlID),
+		MetadataID:     toNullString(md.ID),
+		SubjectID:      toNullString(subjectID),
+		AssessmentID:   toNullString(assessmentID),
+		RuleID:         toNullString(ruleID),
+		IdempotencyKey: toNullString(r.GetIdempotencyKey()),
+		RequestHash:    toNullString(hash),
+	}
+	return result, md, nil
+}
+
+func findOrCreateSubject(tx *gorm.DB, name string) (string, error) {
+	s := models.Subject{}
+	err := tx.Where("name = ?", name).Take(&s).Error
+	if err == nil {
+		return s.ID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", fmt.Errorf("failed to lookup subject %s: %w", name, err)
+	}
+
+	s = models.Subject{ID: uuid.NewString(), Name: name}
+	if err := tx.Create(&s).Error; err != nil {
+		return "", fmt.Errorf("failed to create subject %s: %w", name, err)
+	}
+	return s.ID, nil
+}
+
+// findControl looks up a control by name. Catalogs and profiles can have
+// controls with the same name, so catalog controls are preferred over
+// profile copies and controls that were created for results, and ties are
+// broken by catalog so the same control is always picked. It returns
+// gorm.ErrRecordNotFound if no control has the name.
+func findControl(tx *gorm.DB, name string) (*models.Control, error) {
+	c := &models.Control{}
+	err := tx.Where("name = ?", name).
+		Order("profile_id IS NOT NULL, catalog_id NULLS LAST, id").
+		First(c).Error
+	return c, err
+}
+
+// findOrCreateControl returns the ID of the control findControl picks, and
+// only creates a control if none has the name.
+func findOrCreateControl(tx *gorm.DB, name, severity string) (string, error) {
+	found, err := findControl(tx, name)
+	if err == nil {
+		return found.ID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", fmt.Errorf("failed to lookup control %s: %w", name, err)
+	}
+
+	c := models.Control{ID: uuid.NewString(), Name: name, Severity: toNullString(severity)}
+	if err := tx.Create(&c).Error; err != nil {
+		return "", fmt.Errorf("failed to create control %s: %w", name, err)
+	}
+	return c.ID, nil
+}
+
+// newMetadata records when the result was submitted. Any extra information
+// provided by the client is stored as JSON in the description since it
+// doesn't map to anything in the schema.
+func newMetadata(extra map[string]string) (*models.Metadata, error) {
+	now := time.Now().UTC()
+	md := &models.Metadata{ID: uuid.NewString(), CreatedAt: now, UpdatedAt: now}
+	if len(extra) > 0 {
+		b, err := json.Marshal(extra)
+		if err != nil {
+			return nil, invalidField("extra", "failed to encode extra result information: %s", err)
+		}
+		md.Description = toNullString(string(b))
+	}
+	return md, nil
+}
+
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,250 @@
+package compserv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func validateSetRuleRequest(r *SetRuleRequest) error {
+	err := firstError(
+		checkRequired("name", r.GetName()),
+		checkLength("name", r.GetName(), maxNameLength),
+		checkLength("title", r.GetTitle(), maxNameLength),
+		checkLength("severity", r.GetSeverity(), maxSeverityLength),
+	)
+	if err != nil {
+		return err
+	}
+	for i, c := range r.GetControls() {
+		field := fmt.Sprintf("controls[%d]", i)
+		if c == "" {
+			return invalidField(field, "control names can't be empty")
+		}
+		if len(c) > maxNameLength {
+			return invalidField(field, "control must be %d characters or less", maxNameLength)
+		}
+	}
+	return nil
+}
+
+func findOrCreateRule(tx *gorm.DB, name, severity string) (string, error) {
+	r := models.Rule{}
+	err := tx.Where("name = ?", name).Take(&r).Error
+	if err == nil {
+		return r.ID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", fmt.Errorf("failed to lookup rule %s: %w", name, err)
+	}
+
+	r = models.Rule{ID: uuid.NewString(), Name: name, Severity: toNullString(severity)}
+	if err := tx.Create(&r).Error; err != nil {
+		return "", fmt.Errorf("failed to create rule %s: %w", name, err)
+	}
+	return r.ID, nil
+}
+
+// linkRuleControl maps a rule to a control, unless it's already mapped.
+func linkRuleControl(tx *gorm.DB, ruleID, controlID string) error {
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.RuleControl{RuleID: ruleID, ControlID: controlID}).Error
+	if err != nil {
+		return fmt.Errorf("failed to map rule %s to control %s: %w", ruleID, controlID, err)
+	}
+	return nil
+}
+
+// mappedRules selects the IDs of the rules mapped to controls matching a
+// condition. The controls are aliased as mapped so the condition doesn't
+// clash with controls joined by the outer query.
+func mappedRules(db *gorm.DB, query string, args ...interface{}) *gorm.DB {
+	return db.Table("rule_controls").Select("rule_controls.rule_id").
+		Joins("JOIN controls AS mapped ON mapped.id = rule_controls.control_id").
+		Where(query, args...)
+}
+
+// ruleControlNames returns the names of the controls mapped to each rule.
+func ruleControlNames(db *gorm.DB, ruleIDs []string) (map[string][]string, error) {
+	var rows []struct {
+		RuleID string
+		Name   string
+	}
+	err := db.Table("rule_controls").Select("rule_controls.rule_id, controls.name").
+		Joins("JOIN controls ON controls.id = rule_controls.control_id").
+		Where("rule_controls.rule_id IN ?", ruleIDs).
+		Order("controls.name").Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup controls of rules: %w", err)
+	}
+	names := map[string][]string{}
+	for _, row := range rows {
+		names[row.RuleID] = append(names[row.RuleID], row.Name)
+	}
+	return names, nil
+}
+
+func toRuleMessage(r *models.Rule, controls []string) *Rule {
+	m := &Rule{
+		Id:          r.ID,
+		Name:        r.Name,
+		Title:       r.Title.String,
+		Description: r.Description.String,
+		Severity:    r.Severity.String,
+		Rationale:   r.Rationale.String,
+		Controls:    controls,
+	}
+	if r.CheckMetadata.Valid {
+		if err := json.Unmarshal([]byte(r.CheckMetadata.String), &m.CheckMetadata); err != nil {
+			log.Printf("Ignoring malformed check metadata for rule %s: %s", r.ID, err)
+		}
+	}
+	return m
+}
+
+func (s *server) SetRule(ctx context.Context, request *SetRuleRequest) (*Rule, error) {
+	if err := validateSetRuleRequest(request); err != nil {
+		return nil, err
+	}
+	checkMetadata := ""
+	if len(request.GetCheckMetadata()) > 0 {
+		b, err := json.Marshal(request.GetCheckMetadata())
+		if err != nil {
+			return nil, invalidField("checkMetadata", "failed to encode check metadata: %s", err)
+		}
+		checkMetadata = string(b)
+	}
+
+	r := models.Rule{}
+	var controls []string
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("name = ?", request.GetName()).Take(&r).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to lookup rule %s: %w", request.GetName(), err)
+		}
+		exists := err == nil
+		if !exists {
+			r.ID = uuid.NewString()
+		}
+		r.Name = request.GetName()
+		r.Title = toNullString(request.GetTitle())
+		r.Description = toNullString(request.GetDescription())
+		r.Severity = toNullString(request.GetSeverity())
+		r.Rationale = toNullString(request.GetRationale())
+		r.CheckMetadata = toNullString(checkMetadata)
+		if exists {
+			err = tx.Save(&r).Error
+		} else {
+			err = tx.Create(&r).Error
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save rule %s: %w", r.Name, err)
+		}
+
+		if err := tx.Where("rule_id = ?", r.ID).Delete(&models.RuleControl{}).Error; err != nil {
+			return fmt.Errorf("failed to delete controls of rule %s: %w", r.Name, err)
+		}
+		seen := map[string]bool{}
+		for _, name := range request.GetControls() {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			controlID, err := findOrCreateControl(tx, name, request.GetSeverity())
+			if err != nil {
+				return err
+			}
+			if err := linkRuleControl(tx, r.ID, controlID); err != nil {
+				return err
+			}
+		}
+		names, err := ruleControlNames(tx, []string{r.ID})
+		controls = names[r.ID]
+		return err
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toRuleMessage(&r, controls), nil
+}
+
+func (s *server) GetRule(ctx context.Context, request *GetRuleRequest) (*Rule, error) {
+	db := s.database.WithContext(ctx)
+	r := models.Rule{}
+	var err error
+	switch {
+	case request.GetId() != "" && request.GetName() != "":
+		return nil, invalidField("name", "only one of id or name can be provided")
+	case request.GetId() != "":
+		if err := checkUUID("id", request.GetId()); err != nil {
+			return nil, err
+		}
+		err = db.Where("id = ?", request.GetId()).Take(&r).Error
+	case request.GetName() != "":
+		err = db.Where("name = ?", request.GetName()).Take(&r).Error
+	default:
+		return nil, invalidField("id", "id or name is required")
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "rule does not exist")
+	}
+	if err != nil {
+		return nil, toStatusError(fmt.Errorf("failed to lookup rule: %w", err))
+	}
+	names, err := ruleControlNames(db, []string{r.ID})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toRuleMessage(&r, names[r.ID]), nil
+}
+
+func (s *server) ListRules(ctx context.Context, request *ListRulesRequest) (*ListRulesResponse, error) {
+	if err := checkLength("control", request.GetControl(), maxNameLength); err != nil {
+		return nil, err
+	}
+	after, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	size := getPageSize(request.GetPageSize())
+
+	db := s.database.WithContext(ctx)
+	q := db.Model(&models.Rule{})
+	if c := request.GetControl(); c != "" {
+		q = q.Where("id IN (?)", mappedRules(db, "mapped.name = ? OR mapped.oscal_id = ?", c, c))
+	}
+	if after != "" {
+		q = q.Where("id > ?", after)
+	}
+	var rules []models.Rule
+	if err := q.Order("id").Limit(size + 1).Find(&rules).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &ListRulesResponse{}
+	if len(rules) > size {
+		rules = rules[:size]
+		response.NextPageToken = encodePageToken(rules[size-1].ID)
+	}
+	ids := make([]string, 0, len(rules))
+	for i := range rules {
+		ids = append(ids, rules[i].ID)
+	}
+	names, err := ruleControlNames(db, ids)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	for i := range rules {
+		response.Rules = append(response.Rules, toRuleMessage(&rules[i], names[rules[i].ID]))
+	}
+	return response, nil
+}
@@ -2,22 +2,131 @@ package compserv
 
 import (
 	context "context"
-	"log"
+	"errors"
+	"io"
 
+	evidence "github.com/rhmdnd/compserv/pkg/evidence"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
 type server struct {
 	UnimplementedComplianceServiceServer
 	database *gorm.DB
+	results  *resultHub
+	evidence evidence.Store
+	// maxEvidenceSize is the size in bytes of the largest evidence we
+	// accept.
+	maxEvidenceSize int64
 }
 
-func NewServer(db *gorm.DB) *server { // nolint:revive,golint // returning a private struct from an exported fn is fine
-	return &server{database: db}
+// ServerOption configures an optional part of the service.
+type ServerOption func(*server)
+
+// WithEvidenceStore keeps the contents of evidence in a blob store and
+// rejects evidence larger than maxSize bytes. Evidence can't be uploaded or
+// downloaded without a store.
+func WithEvidenceStore(store evidence.Store, maxSize int64) ServerOption {
+	return func(s *server) {
+		s.evidence = store
+		s.maxEvidenceSize = maxSize
+	}
+}
+
+// nolint:revive,golint // returning a private struct from an exported fn is fine
+func NewServer(db *gorm.DB, opts ...ServerOption) *server {
+	s := &server{database: db, results: newResultHub(db)}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *server) SetResult(ctx context.Context, result *ResultRequest) (*ResultResponse, error) {
-	log.Printf("%+v", result)
-	// Wire this up to the database and persist the result
-	return &ResultResponse{}, nil
+	if err := validateResultRequest(result); err != nil {
+		return nil, err
+	}
+
+	var id string
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		id, err = persistResult(tx, result)
+		return err
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &ResultResponse{Id: id}, nil
+}
+
+func (s *server) SetResults(stream ComplianceService_SetResultsServer) error {
+	response := &SetResultsResponse{}
+	b := newResultBatch(s.database.WithContext(stream.Context()), response)
+	for index := int64(0); ; index++ {
+		result, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := validateResultRequest(result); err != nil {
+			rejectResult(response, index, err)
+			continue
+		}
+		b.add(index, result)
+		if b.full() {
+			b.flush()
+		}
+	}
+	b.flush()
+	return stream.SendAndClose(response)
+}
+
+func (s *server) GetResult(ctx context.Context, request *GetResultRequest) (*Result, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	var rows []resultRow
+	q := selectResults(s.database.WithContext(ctx)).Where("results.id = ?", request.GetId())
+	if err := q.Limit(1).Scan(&rows).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+	if len(rows) == 0 {
+		return nil, status.Errorf(codes.NotFound, "result %s does not exist", request.GetId())
+	}
+	return toResultMessage(&rows[0]), nil
+}
+
+func (s *server) ListResults(ctx context.Context, request *ListResultsRequest) (*ListResultsResponse, error) {
+	if err := validateResultFilter(request.GetFilter()); err != nil {
+		return nil, err
+	}
+	after, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	size := getPageSize(request.GetPageSize())
+
+	q := filterResults(selectResults(s.database.WithContext(ctx)), request.GetFilter())
+	if after != "" {
+		q = q.Where("results.id > ?", after)
+	}
+	// Fetch one more result than we need so we know if there is another
+	// page.
+	var rows []resultRow
+	if err := q.Order("results.id").Limit(size + 1).Scan(&rows).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &ListResultsResponse{}
+	if len(rows) > size {
+		rows = rows[:size]
+		response.NextPageToken = encodePageToken(rows[size-1].ID)
+	}
+	for i := range rows {
+		response.Results = append(response.Results, toResultMessage(&rows[i]))
+	}
+	return response, nil
 }
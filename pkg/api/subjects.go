@@ -0,0 +1,427 @@
+package compserv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const maxTypeLength = 50
+
+// maxSubjectDepth bounds how far we'll walk the subject hierarchy. The API
+// doesn't allow cycles, but this protects recursive queries from running
+// forever if one is introduced by modifying the database directly. Subjects
+// can't be nested deeper than this, so walks never miss part of a tree.
+const maxSubjectDepth = 64
+
+// subjectAncestorsCTE selects the ID of a subject and each of its ancestors
+// along with how far up the hierarchy they are. It expects the subject ID
+// and a maximum depth as arguments.
+const subjectAncestorsCTE = `WITH RECURSIVE ancestors AS (
+	SELECT id, parent_id, 0 AS depth FROM subjects WHERE id = ?
+	UNION ALL
+	SELECT subjects.id, subjects.parent_id, ancestors.depth + 1 FROM subjects
+	JOIN ancestors ON subjects.id = ancestors.parent_id
+	WHERE ancestors.depth < ?
+)`
+
+// subjectSubtreeCTE selects the ID and depth of a subject and every subject
+// below it. It expects the root subject ID and a maximum depth as arguments.
+const subjectSubtreeCTE = `WITH RECURSIVE subtree AS (
+	SELECT id, 0 AS depth FROM subjects WHERE id = ?
+	UNION ALL
+	SELECT subjects.id, subtree.depth + 1 FROM subjects
+	JOIN subtree ON subjects.parent_id = subtree.id
+	WHERE subtree.depth < ?
+)`
+
+// subjectSubtree returns a subquery that selects the IDs of a subject and all
+// of its descendants. It's meant to be used as an argument to a query, like
+// db.Where("subject_id IN (?)", subjectSubtree(db, id)).
+func subjectSubtree(db *gorm.DB, id string) *gorm.DB {
+	return db.Raw(subjectSubtreeCTE+" SELECT id FROM subtree", id, maxSubjectDepth)
+}
+
+func validateSubject(name, subjectType, parentID string) error {
+	err := firstError(
+		checkRequired("name", name),
+		checkLength("name", name, maxNameLength),
+		checkLength("type", subjectType, maxTypeLength),
+	)
+	if err != nil {
+		return err
+	}
+	if parentID != "" {
+		return checkUUID("parentId", parentID)
+	}
+	return nil
+}
+
+func findSubject(tx *gorm.DB, id string) (*models.Subject, error) {
+	s := &models.Subject{}
+	err := tx.Where("id = ?", id).Take(s).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "subject %s does not exist", id)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to lookup subject %s: %w", id, err)
+	}
+	return s, nil
+}
+
+// findParentSubject is like findSubject, but a missing parent is a problem
+// with the request rather than the subject we're looking for.
+func findParentSubject(tx *gorm.DB, id string) error {
+	if id == "" {
+		return nil
+	}
+	_, err := findSubject(tx, id)
+	if status.Code(err) == codes.NotFound {
+		return invalidField("parentId", "parent subject %s does not exist", id)
+	}
+	return err
+}
+
+func toSubjectMessage(s *models.Subject) *Subject {
+	return &Subject{
+		Id:       s.ID,
+		Name:     s.Name,
+		Type:     s.Type.String,
+		ParentId: s.ParentID.String,
+	}
+}
+
+// checkSubjectDepth makes sure placing a subject, and everything below it,
+// under the parent doesn't nest subjects deeper than maxSubjectDepth. The
+// subject ID is empty for new subjects.
+func checkSubjectDepth(tx *gorm.DB, parentID, id string) error {
+	if parentID == "" {
+		return nil
+	}
+	var depth int
+	// The walk goes one level past the limit so we can tell when it's
+	// exceeded.
+	q := tx.Raw(subjectAncestorsCTE+" SELECT MAX(depth) FROM ancestors", parentID, maxSubjectDepth+1)
+	if err := q.Scan(&depth).Error; err != nil {
+		return fmt.Errorf("failed to check depth of subject %s: %w", parentID, err)
+	}
+	depth++
+	if id != "" {
+		var height int
+		q := tx.Raw(subjectSubtreeCTE+" SELECT MAX(depth) FROM subtree", id, maxSubjectDepth)
+		if err := q.Scan(&height).Error; err != nil {
+			return fmt.Errorf("failed to check height of subject %s: %w", id, err)
+		}
+		depth += height
+	}
+	if depth > maxSubjectDepth {
+		return invalidField("parentId", "subjects can't be nested more than %d levels deep", maxSubjectDepth)
+	}
+	return nil
+}
+
+func (s *server) CreateSubject(ctx context.Context, request *CreateSubjectRequest) (*Subject, error) {
+	if err := validateSubject(request.GetName(), request.GetType(), request.GetParentId()); err != nil {
+		return nil, err
+	}
+	subject := &models.Subject{
+		ID:       uuid.NewString(),
+		Name:     request.GetName(),
+		Type:     toNullString(request.GetType()),
+		ParentID: toNullString(request.GetParentId()),
+	}
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := findParentSubject(tx, request.GetParentId()); err != nil {
+			return err
+		}
+		if err := checkSubjectDepth(tx, request.GetParentId(), ""); err != nil {
+			return err
+		}
+		if err := tx.Create(subject).Error; err != nil {
+			return fmt.Errorf("failed to create subject: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toSubjectMessage(subject), nil
+}
+
+func (s *server) GetSubject(ctx context.Context, request *GetSubjectRequest) (*Subject, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	db := s.database.WithContext(ctx)
+	subject, err := findSubject(db, request.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	m := toSubjectMessage(subject)
+	if err := loadSubjectLabels(db, m); err != nil {
+		return nil, toStatusError(err)
+	}
+	return m, nil
+}
+
+func (s *server) UpdateSubject(ctx context.Context, request *UpdateSubjectRequest) (*Subject, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	if err := validateSubject(request.GetName(), request.GetType(), request.GetParentId()); err != nil {
+		return nil, err
+	}
+
+	var subject *models.Subject
+	db := s.database.WithContext(ctx)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if subject, err = findSubject(tx, request.GetId()); err != nil {
+			return err
+		}
+		if err := findParentSubject(tx, request.GetParentId()); err != nil {
+			return err
+		}
+		// Moving a subject below itself would create a cycle in the
+		// hierarchy.
+		if parentID := request.GetParentId(); parentID != "" {
+			var count int64
+			q := tx.Table("subjects").Where("id IN (?)", subjectSubtree(tx, subject.ID)).Where("id = ?", parentID)
+			if err := q.Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to check subject hierarchy: %w", err)
+			}
+			if count > 0 {
+				return invalidField("parentId", "subject %s can't be a descendant of itself", subject.ID)
+			}
+		}
+		if err := checkSubjectDepth(tx, request.GetParentId(), subject.ID); err != nil {
+			return err
+		}
+
+		subject.Name = request.GetName()
+		subject.Type = toNullString(request.GetType())
+		subject.ParentID = toNullString(request.GetParentId())
+		if err := tx.Save(subject).Error; err != nil {
+			return fmt.Errorf("failed to update subject %s: %w", subject.ID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	m := toSubjectMessage(subject)
+	if err := loadSubjectLabels(db, m); err != nil {
+		return nil, toStatusError(err)
+	}
+	return m, nil
+}
+
+func (s *server) ListSubjects(ctx context.Context, request *ListSubjectsRequest) (*ListSubjectsResponse, error) {
+	if id := request.GetParentId(); id != "" {
+		if err := checkUUID("parentId", id); err != nil {
+			return nil, err
+		}
+	}
+	selector, err := parseLabelSelector("labelSelector", request.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	size := getPageSize(request.GetPageSize())
+
+	db := s.database.WithContext(ctx)
+	q := db
+	if request.GetParentId() != "" {
+		q = q.Where("parent_id = ?", request.GetParentId())
+	}
+	if request.GetType() != "" {
+		q = q.Where("type = ?", request.GetType())
+	}
+	if request.GetLabelSelector() != "" {
+		q = q.Where("id IN (?)", labeledSubjects(db, selector))
+	}
+	if after != "" {
+		q = q.Where("id > ?", after)
+	}
+	var subjects []models.Subject
+	if err := q.Order("id").Limit(size + 1).Find(&subjects).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &ListSubjectsResponse{}
+	if len(subjects) > size {
+		subjects = subjects[:size]
+		response.NextPageToken = encodePageToken(subjects[size-1].ID)
+	}
+	for i := range subjects {
+		response.Subjects = append(response.Subjects, toSubjectMessage(&subjects[i]))
+	}
+	if err := loadSubjectLabels(db, response.Subjects...); err != nil {
+		return nil, toStatusError(err)
+	}
+	return response, nil
+}
+
+func (s *server) DeleteSubject(ctx context.Context, request *DeleteSubjectRequest) (*DeleteSubjectResponse, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSubjectResponse{}
+	var evidenceIDs []string
+	err := s.database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := findSubject(tx, request.GetId()); err != nil {
+			return err
+		}
+		if !request.GetCascade() {
+			return deleteSubject(tx, request.GetId(), response)
+		}
+		var err error
+		evidenceIDs, err = deleteSubjectTree(tx, request.GetId(), response)
+		return err
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	// The contents of evidence are only removed once we know the rows
+	// referencing them are gone for good.
+	s.deleteEvidenceContents(evidenceIDs...)
+	return response, nil
+}
+
+// deleteSubject deletes a single subject, but only if nothing references it.
+// We check this explicitly, rather than relying on the foreign key
+// constraints, so we can tell the client why the subject can't be deleted.
+func deleteSubject(tx *gorm.DB, id string, response *DeleteSubjectResponse) error {
+	var children int64
+	if err := tx.Model(&models.Subject{}).Where("parent_id = ?", id).Count(&children).Error; err != nil {
+		return fmt.Errorf("failed to count children of subject %s: %w", id, err)
+	}
+	if children > 0 {
+		return status.Errorf(codes.FailedPrecondition,
+			"subject %s has %d children, delete them first or use cascade", id, children)
+	}
+	var results int64
+	if err := tx.Model(&models.Result{}).Where("subject_id = ?", id).Count(&results).Error; err != nil {
+		return fmt.Errorf("failed to count results of subject %s: %w", id, err)
+	}
+	if results > 0 {
+		return status.Errorf(codes.FailedPrecondition,
+			"subject %s has %d results, delete them first or use cascade", id, results)
+	}
+	var exceptions int64
+	if err := tx.Model(&models.Exception{}).Where("subject_id = ?", id).Count(&exceptions).Error; err != nil {
+		return fmt.Errorf("failed to count exceptions of subject %s: %w", id, err)
+	}
+	if exceptions > 0 {
+		return status.Errorf(codes.FailedPrecondition,
+			"subject %s has %d exceptions, use cascade to delete them", id, exceptions)
+	}
+
+	if err := tx.Where("subject_id = ?", id).Delete(&models.SubjectLabel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete labels of subject %s: %w", id, err)
+	}
+	q := tx.Where("id = ?", id).Delete(&models.Subject{})
+	if q.Error != nil {
+		return fmt.Errorf("failed to delete subject %s: %w", id, q.Error)
+	}
+	response.Deleted = q.RowsAffected
+	return nil
+}
+
+// deleteSubjectTree deletes a subject, all of its descendants, and any
+// results, evidence, rollups, exceptions or labels that reference them. The subjects
+// are deleted in a single statement so the parent foreign key constraint is
+// satisfied once the statement completes. It returns the IDs of the deleted
+// evidence so the caller can remove their contents after committing.
+func deleteSubjectTree(tx *gorm.DB, id string, response *DeleteSubjectResponse) ([]string, error) {
+	q := tx.Where("subject_id IN (?)", subjectSubtree(tx, id)).Delete(&models.DailyComplianceRollup{})
+	if q.Error != nil {
+		return nil, fmt.Errorf("failed to delete rollups for subject %s: %w", id, q.Error)
+	}
+	results := tx.Model(&models.Result{}).Select("id").Where("subject_id IN (?)", subjectSubtree(tx, id))
+	var evidenceIDs []string
+	err := tx.Model(&models.Evidence{}).Where("result_id IN (?)", results).Pluck("id", &evidenceIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup evidence for subject %s: %w", id, err)
+	}
+	q = tx.Where("result_id IN (?)", results).Delete(&models.Evidence{})
+	if q.Error != nil {
+		return nil, fmt.Errorf("failed to delete evidence for subject %s: %w", id, q.Error)
+	}
+	q = tx.Where("result_id IN (?)", results).Delete(&models.ResultHistory{})
+	if q.Error != nil {
+		return nil, fmt.Errorf("failed to delete result history for subject %s: %w", id, q.Error)
+	}
+	q = tx.Where("subject_id IN (?)", subjectSubtree(tx, id)).Delete(&models.Result{})
+	if q.Error != nil {
+		return nil, fmt.Errorf("failed to delete results for subject %s: %w", id, q.Error)
+	}
+	q = tx.Where("subject_id IN (?)", subjectSubtree(tx, id)).Delete(&models.Exception{})
+	if q.Error != nil {
+		return nil, fmt.Errorf("failed to delete exceptions for subject %s: %w", id, q.Error)
+	}
+	q = tx.Where("subject_id IN (?)", subjectSubtree(tx, id)).Delete(&models.SubjectLabel{})
+	if q.Error != nil {
+		return nil, fmt.Errorf("failed to delete labels for subject %s: %w", id, q.Error)
+	}
+	q = tx.Where("id IN (?)", subjectSubtree(tx, id)).Delete(&models.Subject{})
+	if q.Error != nil {
+		return nil, fmt.Errorf("failed to delete subject %s: %w", id, q.Error)
+	}
+	response.Deleted = q.RowsAffected
+	return evidenceIDs, nil
+}
+
+// subjectDescendantRow is a subject along with its distance from the root of
+// the subtree we're walking.
+type subjectDescendantRow struct {
+	models.Subject
+	Depth int32
+}
+
+func (s *server) ListSubjectDescendants(ctx context.Context,
+	request *ListSubjectDescendantsRequest,
+) (*ListSubjectDescendantsResponse, error) {
+	if err := checkUUID("id", request.GetId()); err != nil {
+		return nil, err
+	}
+	maxDepth := maxSubjectDepth
+	if d := int(request.GetMaxDepth()); d > 0 && d < maxSubjectDepth {
+		maxDepth = d
+	}
+
+	db := s.database.WithContext(ctx)
+	if _, err := findSubject(db, request.GetId()); err != nil {
+		return nil, toStatusError(err)
+	}
+	var rows []subjectDescendantRow
+	q := db.Raw(subjectSubtreeCTE+` SELECT subjects.id, subjects.name, subjects.type, subjects.parent_id,
+		subjects.metadata_id, subtree.depth FROM subtree
+		JOIN subjects ON subjects.id = subtree.id
+		WHERE subtree.depth > 0
+		ORDER BY subtree.depth, subjects.name, subjects.id`, request.GetId(), maxDepth)
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &ListSubjectDescendantsResponse{}
+	subjects := make([]*Subject, 0, len(rows))
+	for i := range rows {
+		d := &SubjectDescendant{Subject: toSubjectMessage(&rows[i].Subject), Depth: rows[i].Depth}
+		response.Descendants = append(response.Descendants, d)
+		subjects = append(subjects, d.Subject)
+	}
+	if err := loadSubjectLabels(db, subjects...); err != nil {
+		return nil, toStatusError(err)
+	}
+	return response, nil
+}
@@ -0,0 +1,243 @@
+package compserv
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// subjectAncestryCTE pairs every subject that has a summarized result with
+// itself and each of its ancestors, which lets us roll result counts up the
+// hierarchy with a single join. It expects the summarized result IDs and a
+// maximum depth as arguments.
+const subjectAncestryCTE = `WITH RECURSIVE ancestry AS (
+	SELECT id AS subject_id, id AS ancestor_id, 0 AS depth FROM subjects
+	WHERE id IN (SELECT subject_id FROM results WHERE id IN (?))
+	UNION ALL
+	SELECT ancestry.subject_id, subjects.parent_id, ancestry.depth + 1 FROM ancestry
+	JOIN subjects ON subjects.id = ancestry.ancestor_id
+	WHERE subjects.parent_id IS NOT NULL AND ancestry.depth < ?
+)`
+
+// summaryRow is the number of results with an outcome in a group. Groups
+// with a position are ordered by it.
+type summaryRow struct {
+	Key      string
+	Name     string
+	Position sql.NullInt64
+	Outcome  string
+	Count    int64
+}
+
+// summarizedResults selects the IDs of the results included in a summary.
+func summarizedResults(db *gorm.DB, request *GetComplianceSummaryRequest) *gorm.DB {
+	q := filterResults(selectResults(db).Select("results.id"), request.GetFilter()).
+		Joins("LEFT JOIN assessments ON assessments.id = results.assessment_id").
+		Where("assessments.state IS DISTINCT FROM ?", assessmentAbandoned)
+	if id := request.GetRootSubjectId(); id != "" {
+		q = q.Where("results.subject_id IN (?)", subjectSubtree(db, id))
+	}
+	if request.GetLatest() {
+		q = q.Select("DISTINCT ON (results.subject_id, results.name) results.id").
+			Order("results.subject_id, results.name, metadata.created_at DESC NULLS LAST, results.id DESC")
+	}
+	return q
+}
+
+// countOutcomes counts the outcomes of the summarized results in each group.
+// Failures covered by an exception that is active at the given time are
+// counted as excepted.
+func countOutcomes(db *gorm.DB, request *GetComplianceSummaryRequest, now time.Time) ([]summaryRow, error) {
+	ids := summarizedResults(db, request)
+	outcome, outcomeArgs := effectiveOutcome(now)
+	outcome += " AS outcome"
+	var q *gorm.DB
+	switch request.GetGroupBy() {
+	case SummaryGrouping_SUMMARY_GROUPING_UNSPECIFIED:
+		q = db.Table("results").Select("'' AS key, '' AS name, "+outcome+", COUNT(*) AS count", outcomeArgs...).
+			Where("results.id IN (?)", ids).Group("1, 2, 3")
+	case SummaryGrouping_SUMMARY_GROUPING_SUBJECT, SummaryGrouping_SUMMARY_GROUPING_SUBJECT_TYPE:
+		key := "ancestors.id::text AS key, ancestors.name"
+		if request.GetGroupBy() == SummaryGrouping_SUMMARY_GROUPING_SUBJECT_TYPE {
+			key = "COALESCE(ancestors.type, '') AS key, COALESCE(ancestors.type, '') AS name"
+		}
+		// Nested subjects of the same type share results, so count each
+		// result once per group.
+		sql := subjectAncestryCTE + ` SELECT ` + key + `, ` + outcome + `, COUNT(DISTINCT results.id) AS count
+			FROM results
+			JOIN ancestry ON ancestry.subject_id = results.subject_id
+			JOIN subjects AS ancestors ON ancestors.id = ancestry.ancestor_id
+			WHERE results.id IN (?)`
+		args := append([]interface{}{ids, maxSubjectDepth}, outcomeArgs...)
+		args = append(args, ids)
+		if id := request.GetRootSubjectId(); id != "" {
+			sql += ` AND ancestors.id IN (?)`
+			args = append(args, subjectSubtree(db, id))
+		}
+		q = db.Raw(sql+` GROUP BY 1, 2, 3`, args...)
+	case SummaryGrouping_SUMMARY_GROUPING_SEVERITY:
+		q = db.Table("results").
+			Select("COALESCE(controls.severity, '') AS key, COALESCE(controls.severity, '') AS name, "+
+				outcome+", COUNT(*) AS count", outcomeArgs...).
+			Joins("LEFT JOIN controls ON controls.id = results.control_id").
+			Where("results.id IN (?)", ids).Group("1, 2, 3")
+	case SummaryGrouping_SUMMARY_GROUPING_FAMILY:
+		q = db.Table("results").
+			Select("COALESCE(hierarchy.family_id::text, '') AS key, COALESCE(hierarchy.family, '') AS name, "+
+				"MIN(hierarchy.family_position) AS position, "+outcome+", COUNT(*) AS count", outcomeArgs...).
+			Joins("LEFT JOIN controls ON controls.id = results.control_id").
+			Joins(controlHierarchyJoin).
+			Where("results.id IN (?)", ids).Group("1, 2, 4")
+	case SummaryGrouping_SUMMARY_GROUPING_CONTROL:
+		q = db.Table("results").
+			Select("COALESCE(hierarchy.control, controls.name, '') AS key, "+
+				"COALESCE(hierarchy.control, controls.name, '') AS name, "+
+				"MIN(hierarchy.control_position) AS position, "+outcome+", COUNT(*) AS count", outcomeArgs...).
+			Joins("LEFT JOIN controls ON controls.id = results.control_id").
+			Joins(controlHierarchyJoin).
+			Where("results.id IN (?)", ids).Group("1, 2, 4")
+	case SummaryGrouping_SUMMARY_GROUPING_ASSESSMENT:
+		q = db.Table("results").
+			Select("COALESCE(results.assessment_id::text, '') AS key, COALESCE(assessments.name, '') AS name, "+
+				outcome+", COUNT(*) AS count", outcomeArgs...).
+			Joins("LEFT JOIN assessments ON assessments.id = results.assessment_id").
+			Where("results.id IN (?)", ids).Group("1, 2, 3")
+	default:
+		return nil, invalidField("groupBy", "unknown grouping %d", request.GetGroupBy())
+	}
+
+	var rows []summaryRow
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to summarize results: %w", err)
+	}
+	return rows, nil
+}
+
+// These are the kinds of outcomes we score. Excepted failures and anything
+// else, like NOT-APPLICABLE or MANUAL, are counted but not scored.
+const (
+	outcomeOther = iota
+	outcomePassed
+	outcomeFailed
+	outcomeErrored
+	outcomeExcepted
+)
+
+// classifyOutcome scores an outcome. Outcomes are free form, so we recognize
+// the common spellings used by scanners.
+func classifyOutcome(outcome string) int {
+	switch strings.ToUpper(outcome) {
+	case "PASS", "PASSED":
+		return outcomePassed
+	case "FAIL", "FAILED":
+		return outcomeFailed
+	case "ERROR":
+		return outcomeErrored
+	case exceptedOutcome:
+		return outcomeExcepted
+	default:
+		return outcomeOther
+	}
+}
+
+// addOutcome counts results towards the totals of a summary.
+func addOutcome(summary *ComplianceSummary, outcome string, count int64) {
+	if summary.Outcomes == nil {
+		summary.Outcomes = map[string]int64{}
+	}
+	summary.Outcomes[outcome] += count
+	summary.Total += count
+	switch classifyOutcome(outcome) {
+	case outcomePassed:
+		summary.Passed += count
+	case outcomeFailed:
+		summary.Failed += count
+	case outcomeErrored:
+		summary.Errored += count
+	case outcomeExcepted:
+		summary.Excepted += count
+	}
+	if scored := summary.Passed + summary.Failed + summary.Errored; scored > 0 {
+		summary.PassPercentage = float64(summary.Passed) / float64(scored) * 100
+	}
+}
+
+func (s *server) GetComplianceSummary(ctx context.Context,
+	request *GetComplianceSummaryRequest,
+) (*GetComplianceSummaryResponse, error) {
+	if err := validateResultFilter(request.GetFilter()); err != nil {
+		return nil, err
+	}
+	if _, ok := SummaryGrouping_name[int32(request.GetGroupBy())]; !ok {
+		return nil, invalidField("groupBy", "unknown grouping %d", request.GetGroupBy())
+	}
+	db := s.database.WithContext(ctx)
+	if id := request.GetRootSubjectId(); id != "" {
+		if err := checkUUID("rootSubjectId", id); err != nil {
+			return nil, err
+		}
+		if _, err := findSubject(db, id); err != nil {
+			return nil, toStatusError(err)
+		}
+	}
+
+	// Subject groups overlap, so the overall summary can't be derived
+	// from the groups.
+	overall := &GetComplianceSummaryRequest{
+		Filter:        request.GetFilter(),
+		RootSubjectId: request.GetRootSubjectId(),
+		Latest:        request.GetLatest(),
+	}
+	now := time.Now().UTC()
+	rows, err := countOutcomes(db, overall, now)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	response := &GetComplianceSummaryResponse{Overall: &ComplianceSummary{Outcomes: map[string]int64{}}}
+	for _, row := range rows {
+		addOutcome(response.Overall, row.Outcome, row.Count)
+	}
+	if request.GetGroupBy() == SummaryGrouping_SUMMARY_GROUPING_UNSPECIFIED {
+		return response, nil
+	}
+
+	if rows, err = countOutcomes(db, request, now); err != nil {
+		return nil, toStatusError(err)
+	}
+	groups := map[string]*ComplianceSummary{}
+	positions := map[string]sql.NullInt64{}
+	for _, row := range rows {
+		g, ok := groups[row.Key]
+		if !ok {
+			g = &ComplianceSummary{Key: row.Key, Name: row.Name}
+			groups[row.Key] = g
+			response.Groups = append(response.Groups, g)
+		}
+		if p := positions[row.Key]; row.Position.Valid && (!p.Valid || row.Position.Int64 < p.Int64) {
+			positions[row.Key] = row.Position
+		}
+		addOutcome(g, row.Outcome, row.Count)
+	}
+	// Groups without a position, like results for controls that aren't in
+	// a catalog, sort last.
+	sort.Slice(response.Groups, func(i, j int) bool {
+		a, b := response.Groups[i], response.Groups[j]
+		pa, pb := positions[a.Key], positions[b.Key]
+		if pa.Valid != pb.Valid {
+			return pa.Valid
+		}
+		if pa.Int64 != pb.Int64 {
+			return pa.Int64 < pb.Int64
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Key < b.Key
+	})
+	return response, nil
+}
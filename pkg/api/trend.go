@@ -0,0 +1,194 @@
+package compserv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// defaultTrendPeriod is how far back trends go when the request doesn't
+// include a start.
+const defaultTrendPeriod = 90 * 24 * time.Hour
+
+// rollupLockID is the advisory lock held while refreshing rollups, so only
+// one request at a time recalculates them.
+const rollupLockID = 0x636f6d7073657276
+
+// resultDayExpr is the day a result is counted towards in trends.
+const resultDayExpr = "COALESCE(assessments.started_at, metadata.created_at)::date"
+
+// markRollupsStale flags the days of the given results so their rollups are
+// recalculated before the next trend is calculated. The results are either
+// a slice of IDs or a query selecting IDs. It needs to be called whenever
+// results are added or change days, like when they're attached to an
+// assessment.
+func markRollupsStale(tx *gorm.DB, ids interface{}) error {
+	// clock_timestamp() changes within a transaction, which lets a refresh
+	// tell whether a day was marked again after it read it.
+	err := tx.Exec(`INSERT INTO stale_rollup_days (day, marked_at)
+		SELECT day, clock_timestamp() FROM (
+			SELECT DISTINCT `+resultDayExpr+` AS day FROM results
+			LEFT JOIN assessments ON assessments.id = results.assessment_id
+			LEFT JOIN metadata ON metadata.id = results.metadata_id
+			WHERE results.id IN (?)
+		) AS days WHERE day IS NOT NULL
+		ON CONFLICT (day) DO UPDATE SET marked_at = excluded.marked_at`, ids).Error
+	if err != nil {
+		return fmt.Errorf("failed to mark rollups as stale: %w", err)
+	}
+	return nil
+}
+
+// refreshRollups recalculates the rollups of every stale day. If another
+// refresh is already running it returns right away, and the existing rollups
+// are served until the next refresh.
+func refreshRollups(tx *gorm.DB) error {
+	var locked bool
+	if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", rollupLockID).Scan(&locked).Error; err != nil {
+		return fmt.Errorf("failed to lock rollups: %w", err)
+	}
+	if !locked {
+		return nil
+	}
+	var stale []models.StaleRollupDay
+	if err := tx.Find(&stale).Error; err != nil {
+		return fmt.Errorf("failed to lookup stale rollups: %w", err)
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	days := make([]time.Time, 0, len(stale))
+	marked := make([][]interface{}, 0, len(stale))
+	for _, d := range stale {
+		days = append(days, d.Day)
+		marked = append(marked, []interface{}{d.Day, d.MarkedAt})
+	}
+
+	if err := tx.Where("day IN ?", days).Delete(&models.DailyComplianceRollup{}).Error; err != nil {
+		return fmt.Errorf("failed to delete stale rollups: %w", err)
+	}
+	// The subqueries narrow down the results using the indexes on
+	// assessment and metadata IDs before the day of each result is
+	// calculated.
+	err := tx.Exec(`INSERT INTO daily_compliance_rollups (day, subject_id, control_id, outcome, count)
+		SELECT `+resultDayExpr+`, results.subject_id, results.control_id, COALESCE(results.outcome, ''), COUNT(*)
+		FROM results
+		LEFT JOIN assessments ON assessments.id = results.assessment_id
+		LEFT JOIN metadata ON metadata.id = results.metadata_id
+		WHERE (results.assessment_id IN (SELECT id FROM assessments WHERE started_at::date IN ?)
+			OR results.metadata_id IN (SELECT id FROM metadata WHERE created_at::date IN ?))
+		AND `+resultDayExpr+` IN ?
+		AND assessments.state IS DISTINCT FROM ?
+		AND results.subject_id IS NOT NULL AND results.control_id IS NOT NULL
+		GROUP BY 1, 2, 3, 4`, days, days, days, assessmentAbandoned).Error
+	if err != nil {
+		return fmt.Errorf("failed to calculate rollups: %w", err)
+	}
+	// Days marked again since we read them stay stale, since we may have
+	// missed the results that were added.
+	err = tx.Where("(day, marked_at) IN ?", marked).Delete(&models.StaleRollupDay{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to clear stale rollups: %w", err)
+	}
+	return nil
+}
+
+// trendRow is the number of results with an outcome in a bucket.
+type trendRow struct {
+	Bucket  time.Time
+	Outcome string
+	Count   int64
+}
+
+// trendRange returns the first and last day included in a trend. The first
+// day is moved back to the start of its bucket so every bucket is complete.
+func trendRange(request *GetComplianceTrendRequest) (time.Time, time.Time, error) {
+	end := time.Now().UTC()
+	if request.GetEnd() != nil {
+		if err := request.GetEnd().CheckValid(); err != nil {
+			return time.Time{}, time.Time{}, invalidField("end", "invalid end: %s", err)
+		}
+		end = request.GetEnd().AsTime()
+	}
+	start := end.Add(-defaultTrendPeriod)
+	if request.GetStart() != nil {
+		if err := request.GetStart().CheckValid(); err != nil {
+			return time.Time{}, time.Time{}, invalidField("start", "invalid start: %s", err)
+		}
+		start = request.GetStart().AsTime()
+	}
+	if start.After(end) {
+		return time.Time{}, time.Time{}, invalidField("start", "start must not be after end")
+	}
+
+	const day = 24 * time.Hour
+	start = start.Truncate(day)
+	if request.GetInterval() == TrendInterval_TREND_INTERVAL_WEEK {
+		// Weeks start on Monday, like date_trunc.
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+	}
+	return start, end.Truncate(day), nil
+}
+
+func (s *server) GetComplianceTrend(ctx context.Context,
+	request *GetComplianceTrendRequest,
+) (*GetComplianceTrendResponse, error) {
+	if err := checkLength("control", request.GetControl(), maxNameLength); err != nil {
+		return nil, err
+	}
+	unit := "day"
+	switch request.GetInterval() {
+	case TrendInterval_TREND_INTERVAL_UNSPECIFIED, TrendInterval_TREND_INTERVAL_DAY:
+	case TrendInterval_TREND_INTERVAL_WEEK:
+		unit = "week"
+	default:
+		return nil, invalidField("interval", "unknown interval %d", request.GetInterval())
+	}
+	start, end, err := trendRange(request)
+	if err != nil {
+		return nil, err
+	}
+	db := s.database.WithContext(ctx)
+	if id := request.GetRootSubjectId(); id != "" {
+		if err := checkUUID("rootSubjectId", id); err != nil {
+			return nil, err
+		}
+		if _, err := findSubject(db, id); err != nil {
+			return nil, toStatusError(err)
+		}
+	}
+
+	if err := db.Transaction(refreshRollups); err != nil {
+		return nil, toStatusError(err)
+	}
+	q := db.Table("daily_compliance_rollups").
+		Select("date_trunc(?, daily_compliance_rollups.day::timestamp)::date AS bucket, "+
+			"daily_compliance_rollups.outcome, SUM(daily_compliance_rollups.count) AS count", unit).
+		Where("daily_compliance_rollups.day BETWEEN ? AND ?", start, end)
+	if id := request.GetRootSubjectId(); id != "" {
+		q = q.Where("daily_compliance_rollups.subject_id IN (?)", subjectSubtree(db, id))
+	}
+	if c := request.GetControl(); c != "" {
+		q = q.Joins("JOIN controls ON controls.id = daily_compliance_rollups.control_id").
+			Where("controls.name IN (?)", controlNames(db, matchingControls(db, c, "")))
+	}
+	var rows []trendRow
+	if err := q.Group("1, 2").Order("1, 2").Scan(&rows).Error; err != nil {
+		return nil, toStatusError(fmt.Errorf("failed to calculate compliance trend: %w", err))
+	}
+
+	response := &GetComplianceTrendResponse{}
+	var point *CompliancePoint
+	for _, row := range rows {
+		if point == nil || !point.Start.AsTime().Equal(row.Bucket) {
+			point = &CompliancePoint{Start: timestamppb.New(row.Bucket), Summary: &ComplianceSummary{}}
+			response.Points = append(response.Points, point)
+		}
+		addOutcome(point.Summary, row.Outcome, row.Count)
+	}
+	return response, nil
+}
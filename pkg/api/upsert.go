@@ -0,0 +1,196 @@
+package compserv
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	models "github.com/rhmdnd/compserv/pkg/models"
+	"gorm.io/gorm"
+)
+
+// naturalKeyLockClass namespaces the advisory locks taken on the natural
+// keys of results so they don't collide with other advisory locks.
+const naturalKeyLockClass = 0x6e6b6579
+
+// naturalResultKey identifies a result within an assessment. Scanners may
+// report the same check several times while an assessment runs, and only
+// the latest report is kept as the result.
+type naturalResultKey struct {
+	subjectID    string
+	rule         string
+	assessmentID string
+}
+
+func (k naturalResultKey) String() string {
+	return k.assessmentID + "/" + k.subjectID + "/" + k.rule
+}
+
+// naturalKey returns the natural key of a result. Results that don't
+// belong to an assessment don't have one and are always appended.
+func naturalKey(r *models.Result) (naturalResultKey, bool) {
+	if !r.AssessmentID.Valid || !r.SubjectID.Valid {
+		return naturalResultKey{}, false
+	}
+	return naturalResultKey{subjectID: r.SubjectID.String, rule: r.Name, assessmentID: r.AssessmentID.String}, true
+}
+
+func newResultHistory(r *models.Result, replacedAt time.Time) *models.ResultHistory {
+	return &models.ResultHistory{
+		ResultID:       r.ID,
+		Outcome:        r.Outcome,
+		Instruction:    r.Instruction,
+		Rationale:      r.Rationale,
+		ControlID:      r.ControlID,
+		MetadataID:     r.MetadataID,
+		IdempotencyKey: r.IdempotencyKey,
+		RequestHash:    r.RequestHash,
+		ReplacedAt:     replacedAt,
+	}
+}
+
+// resultUpserts sorts resolved results into the ones that are new and the
+// ones that replace a result already reported during the same assessment.
+type resultUpserts struct {
+	existing map[naturalResultKey]*models.Result
+	latest   map[naturalResultKey]*models.Result
+	inserts  []*models.Result
+	updates  []*models.Result
+	history  []*models.ResultHistory
+	now      time.Time
+}
+
+// newResultUpserts locks the natural keys of the results until the
+// transaction ends and looks up the results already persisted with them.
+// Holding the locks means concurrent reports of the same check are applied
+// one after the other instead of failing on the unique index. Keys are
+// locked in order so overlapping batches can't deadlock.
+func newResultUpserts(tx *gorm.DB, results []*models.Result) (*resultUpserts, error) {
+	ru := &resultUpserts{
+		existing: map[naturalResultKey]*models.Result{},
+		latest:   map[naturalResultKey]*models.Result{},
+		now:      time.Now().UTC(),
+	}
+	var keys []naturalResultKey
+	seen := map[naturalResultKey]bool{}
+	for _, r := range results {
+		if k, ok := naturalKey(r); ok && !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return ru, nil
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	tuples := make([][]interface{}, 0, len(keys))
+	for _, k := range keys {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(?, hashtext(?))", naturalKeyLockClass, k.String()).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock result %s: %w", k, err)
+		}
+		tuples = append(tuples, []interface{}{k.subjectID, k.rule, k.assessmentID})
+	}
+
+	var existing []models.Result
+	if err := tx.Where("(subject_id, name, assessment_id) IN ?", tuples).Find(&existing).Error; err != nil {
+		return nil, fmt.Errorf("failed to lookup existing results: %w", err)
+	}
+	for i := range existing {
+		if k, ok := naturalKey(&existing[i]); ok {
+			ru.existing[k] = &existing[i]
+		}
+	}
+	return ru, nil
+}
+
+// add records a result to persist. A result that replaces another one takes
+// its ID, and the values it replaces are kept as history.
+func (ru *resultUpserts) add(r *models.Result) {
+	k, ok := naturalKey(r)
+	if !ok {
+		ru.inserts = append(ru.inserts, r)
+		return
+	}
+	if latest, ok := ru.latest[k]; ok {
+		// Reported more than once in this batch, so the earlier report
+		// hasn't been written yet.
+		ru.history = append(ru.history, newResultHistory(latest, ru.now))
+		r.ID = latest.ID
+		*latest = *r
+		return
+	}
+	ru.latest[k] = r
+	if existing, ok := ru.existing[k]; ok {
+		ru.history = append(ru.history, newResultHistory(existing, ru.now))
+		r.ID = existing.ID
+		ru.updates = append(ru.updates, r)
+		return
+	}
+	ru.inserts = append(ru.inserts, r)
+}
+
+// save writes the results and returns the IDs of the ones that changed. The
+// metadata of the results must already exist. Replaced results get a new
+// sequence number and record the transaction that replaced them, so watchers
+// see them again.
+func (ru *resultUpserts) save(tx *gorm.DB) ([]string, error) {
+	ids := make([]string, 0, len(ru.inserts)+len(ru.updates))
+	if len(ru.inserts) > 0 {
+		if err := tx.Create(ru.inserts).Error; err != nil {
+			return nil, fmt.Errorf("failed to create results: %w", err)
+		}
+		for _, r := range ru.inserts {
+			ids = append(ids, r.ID)
+		}
+	}
+	for _, r := range ru.updates {
+		// seq is read-only in the model and xid isn't part of it, so
+		// update the table directly.
+		err := tx.Table("results").Where("id = ?", r.ID).Updates(map[string]interface{}{
+			"outcome":         r.Outcome,
+			"instruction":     r.Instruction,
+			"rationale":       r.Rationale,
+			"control_id":      r.ControlID,
+			"metadata_id":     r.MetadataID,
+			"idempotency_key": r.IdempotencyKey,
+			"request_hash":    r.RequestHash,
+			"seq":             gorm.Expr("nextval(pg_get_serial_sequence('results', 'seq'))"),
+			"xid":             gorm.Expr("pg_current_xact_id()::text::bigint"),
+		}).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to update result %s: %w", r.ID, err)
+		}
+		ids = append(ids, r.ID)
+	}
+	if len(ru.history) > 0 {
+		if err := tx.Create(ru.history).Error; err != nil {
+			return nil, fmt.Errorf("failed to create result history: %w", err)
+		}
+	}
+	return ids, nil
+}
+
+// saveResults persists resolved results along with their metadata, updating
+// results that were already reported during the same assessment, and tells
+// anyone interested that they changed.
+func saveResults(tx *gorm.DB, results []*models.Result, mds []*models.Metadata) error {
+	ru, err := newResultUpserts(tx, results)
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		ru.add(r)
+	}
+	if err := tx.Create(mds).Error; err != nil {
+		return fmt.Errorf("failed to create metadata: %w", err)
+	}
+	ids, err := ru.save(tx)
+	if err != nil {
+		return err
+	}
+	if err := markRollupsStale(tx, ids); err != nil {
+		return err
+	}
+	return notifyResults(tx, ids)
+}
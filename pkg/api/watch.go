@@ -0,0 +1,408 @@
+package compserv
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v4/stdlib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// resultsChannel is the PostgreSQL notification channel used to tell every
+// replica of the service about new results.
+const resultsChannel = "compserv_results"
+
+// maxNotifyIDs is the number of result IDs sent in a single notification,
+// which keeps the payload below the 8000 byte limit PostgreSQL imposes.
+const maxNotifyIDs = 200
+
+// watchBufferSize is the number of notifications buffered for a watcher
+// before it's considered too slow and disconnected.
+const watchBufferSize = 128
+
+// watchReplayBatchSize is the number of results read per query when
+// looking for results to send to a watcher.
+const watchReplayBatchSize = 500
+
+// notifyResults announces new results to watchers. Notifications are only
+// delivered if the transaction commits, so watchers never see results that
+// were rolled back.
+func notifyResults(tx *gorm.DB, ids []string) error {
+	for start := 0; start < len(ids); start += maxNotifyIDs {
+		end := start + maxNotifyIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		payload := strings.Join(ids[start:end], ",")
+		if err := tx.Exec("SELECT pg_notify(?, ?)", resultsChannel, payload).Error; err != nil {
+			return fmt.Errorf("failed to notify watchers of new results: %w", err)
+		}
+	}
+	return nil
+}
+
+// resultWatcher receives the IDs of new results for a single WatchResults
+// call. The IDs only tell the watcher there's something new, it reads the
+// results it hasn't seen from the database.
+type resultWatcher struct {
+	ids  chan []string
+	done chan struct{}
+	err  error
+	once sync.Once
+}
+
+func (w *resultWatcher) stop(err error) {
+	w.once.Do(func() {
+		w.err = err
+		close(w.done)
+	})
+}
+
+// resultListener is a connection listening for result notifications.
+type resultListener struct {
+	cancel context.CancelFunc
+	ready  chan struct{}
+	done   chan struct{}
+	err    error
+}
+
+// resultHub shares a single listening connection between every watcher of
+// this replica. The connection is only held while there are watchers.
+type resultHub struct {
+	db       *gorm.DB
+	mu       sync.Mutex
+	watchers map[*resultWatcher]struct{}
+	listener *resultListener
+}
+
+func newResultHub(db *gorm.DB) *resultHub {
+	return &resultHub{db: db, watchers: map[*resultWatcher]struct{}{}}
+}
+
+// subscribe registers a watcher and waits until we're listening for
+// notifications, so the caller knows it won't miss results persisted after
+// subscribe returns.
+func (h *resultHub) subscribe(ctx context.Context) (*resultWatcher, error) {
+	w := &resultWatcher{ids: make(chan []string, watchBufferSize), done: make(chan struct{})}
+	h.mu.Lock()
+	h.watchers[w] = struct{}{}
+	l := h.listener
+	if l == nil {
+		l = h.startListener()
+		h.listener = l
+	}
+	h.mu.Unlock()
+
+	select {
+	case <-l.ready:
+		return w, nil
+	case <-l.done:
+		h.unsubscribe(w)
+		return nil, status.Errorf(codes.Unavailable, "unable to watch results: %s", l.err)
+	case <-ctx.Done():
+		h.unsubscribe(w)
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (h *resultHub) unsubscribe(w *resultWatcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.watchers, w)
+	if len(h.watchers) == 0 && h.listener != nil {
+		h.listener.cancel()
+		h.listener = nil
+	}
+}
+
+// startListener must be called with the lock held.
+func (h *resultHub) startListener() *resultListener {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &resultListener{cancel: cancel, ready: make(chan struct{}), done: make(chan struct{})}
+	go func() {
+		err := h.listen(ctx, l.ready)
+		h.mu.Lock()
+		// The listener is only replaced after it's canceled. Otherwise
+		// we lost the connection and watchers may have missed results,
+		// so they need to resume from their last token.
+		if h.listener == l {
+			h.listener = nil
+			for w := range h.watchers {
+				w.stop(status.Error(codes.Unavailable, "lost connection to the result feed, resume to continue watching"))
+				delete(h.watchers, w)
+			}
+		}
+		h.mu.Unlock()
+		l.err = err
+		close(l.done)
+	}()
+	return l
+}
+
+// listen holds a dedicated connection and publishes notifications until the
+// context is canceled or the connection fails.
+func (h *resultHub) listen(ctx context.Context, ready chan struct{}) error {
+	sqlDB, err := h.db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	defer conn.Close()
+
+	var listenErr error
+	_ = conn.Raw(func(driverConn interface{}) error {
+		listenErr = h.receive(ctx, driverConn, ready)
+		// The connection is still listening, so it can't go back to
+		// the pool.
+		return driver.ErrBadConn
+	})
+	return listenErr
+}
+
+func (h *resultHub) receive(ctx context.Context, driverConn interface{}, ready chan struct{}) error {
+	c, ok := driverConn.(*stdlib.Conn)
+	if !ok {
+		return fmt.Errorf("unsupported database driver %T", driverConn)
+	}
+	pgConn := c.Conn()
+	if _, err := pgConn.Exec(ctx, "LISTEN "+resultsChannel); err != nil {
+		return fmt.Errorf("failed to listen for results: %w", err)
+	}
+	close(ready)
+	for {
+		n, err := pgConn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for results: %w", err)
+		}
+		h.publish(strings.Split(n.Payload, ","))
+	}
+}
+
+// publish hands result IDs to every watcher. Watchers that can't keep up are
+// disconnected rather than slowing down everyone else.
+func (h *resultHub) publish(ids []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for w := range h.watchers {
+		select {
+		case w.ids <- ids:
+		default:
+			w.stop(status.Error(codes.ResourceExhausted, "watcher fell behind the result feed, resume to continue watching"))
+			delete(h.watchers, w)
+		}
+	}
+}
+
+// watchToken is the decoded form of the resume tokens we give to watchers.
+// Xmin is the oldest transaction that was still running when the watcher
+// last looked for results, so everything written by older transactions has
+// been sent. Results are replayed from there rather than from a sequence
+// number, since sequence numbers are assigned before transactions commit.
+type watchToken struct {
+	Xmin int64 `json:"xmin"`
+}
+
+func encodeWatchToken(xmin int64) string {
+	b, _ := json.Marshal(watchToken{Xmin: xmin})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeWatchToken(s string) (watchToken, error) {
+	t := watchToken{}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, invalidField("resumeToken", "invalid resume token")
+	}
+	if err := json.Unmarshal(b, &t); err != nil || t.Xmin <= 0 {
+		return t, invalidField("resumeToken", "invalid resume token")
+	}
+	return t, nil
+}
+
+// currentXmin returns the oldest transaction that's still running. Results
+// written by older transactions are visible to every query that follows.
+func currentXmin(db *gorm.DB) (int64, error) {
+	var xmin int64
+	if err := db.Raw("SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint").Scan(&xmin).Error; err != nil {
+		return 0, fmt.Errorf("failed to lookup running transactions: %w", err)
+	}
+	return xmin, nil
+}
+
+// watchRow is a resultRow along with its sequence number and the
+// transaction that wrote it.
+type watchRow struct {
+	resultRow
+	Seq int64
+	Xid int64
+}
+
+// watchMark identifies a version of a result the watcher has seen.
+type watchMark struct {
+	seq int64
+	xid int64
+}
+
+// resultWatch tracks what's been sent to a watcher.
+type resultWatch struct {
+	stream ComplianceService_WatchResultsServer
+	query  func() *gorm.DB
+	xmin   int64
+	token  string
+	// seen holds the results read since xmin by ID. They're read again
+	// by the next poll, so they'd be sent twice otherwise. Results
+	// replaced since then have a new sequence number, so they're still
+	// sent.
+	seen map[string]watchMark
+}
+
+// poll reads the results matching the condition and, if send is set, sends
+// the ones the watcher hasn't seen. xmin must be read before the results
+// and becomes the watcher's xmin once they're all sent. Until then the
+// watcher could still miss results if it resumed, so only the last result
+// carries the new resume token.
+func (rw *resultWatch) poll(xmin int64, send bool, condition string, args ...interface{}) error {
+	var pending *WatchResultsResponse
+	last := int64(0)
+	for {
+		var rows []watchRow
+		q := rw.query().Where(condition, args...).Where("results.seq > ?", last).
+			Order("results.seq").Limit(watchReplayBatchSize)
+		if err := q.Scan(&rows).Error; err != nil {
+			return toStatusError(err)
+		}
+		for i := range rows {
+			last = rows[i].Seq
+			if m, ok := rw.seen[rows[i].ID]; ok && m.seq == rows[i].Seq {
+				continue
+			}
+			rw.seen[rows[i].ID] = watchMark{seq: rows[i].Seq, xid: rows[i].Xid}
+			if !send {
+				continue
+			}
+			if pending != nil {
+				if err := rw.stream.Send(pending); err != nil {
+					return err
+				}
+			}
+			pending = &WatchResultsResponse{Result: toResultMessage(&rows[i].resultRow), ResumeToken: rw.token}
+		}
+		if len(rows) < watchReplayBatchSize {
+			break
+		}
+	}
+	rw.xmin = xmin
+	rw.token = encodeWatchToken(xmin)
+	for id, m := range rw.seen {
+		if m.xid < xmin {
+			delete(rw.seen, id)
+		}
+	}
+	if pending == nil {
+		return nil
+	}
+	pending.ResumeToken = rw.token
+	return rw.stream.Send(pending)
+}
+
+// WatchResults uses notifications to find out when to look for new results,
+// but reads them from the database. Transactions commit in a different order
+// than they're assigned sequence numbers, so each poll reads every result
+// written since the oldest transaction that was running during the last one.
+func (s *server) WatchResults(request *WatchResultsRequest, stream ComplianceService_WatchResultsServer) error {
+	if err := validateResultFilter(request.GetFilter()); err != nil {
+		return err
+	}
+	rootID := request.GetRootSubjectId()
+	if rootID != "" {
+		if err := checkUUID("rootSubjectId", rootID); err != nil {
+			return err
+		}
+	}
+	var token watchToken
+	resume := request.GetResumeToken() != ""
+	if resume {
+		var err error
+		if token, err = decodeWatchToken(request.GetResumeToken()); err != nil {
+			return err
+		}
+	}
+
+	ctx := stream.Context()
+	db := s.database.WithContext(ctx)
+	if rootID != "" {
+		if _, err := findSubject(db, rootID); err != nil {
+			return toStatusError(err)
+		}
+	}
+	rw := &resultWatch{
+		stream: stream,
+		query: func() *gorm.DB {
+			q := filterResults(selectResults(db).Select(resultColumns+", results.seq, COALESCE(results.xid, 0) AS xid"),
+				request.GetFilter())
+			if rootID != "" {
+				q = q.Where("results.subject_id IN (?)", subjectSubtree(db, rootID))
+			}
+			return q
+		},
+		token: request.GetResumeToken(),
+		seen:  map[string]watchMark{},
+	}
+
+	// Subscribe before looking for results so results persisted in the
+	// meantime aren't missed.
+	w, err := s.results.subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.results.unsubscribe(w)
+
+	xmin, err := currentXmin(db)
+	if err != nil {
+		return toStatusError(err)
+	}
+	if resume {
+		err = rw.poll(xmin, true, "results.xid >= ?", token.Xmin)
+	} else {
+		// Results that are already visible aren't sent, but they're
+		// remembered so the next poll doesn't mistake them for new ones.
+		err = rw.poll(xmin, false, "results.xid >= ?", xmin)
+	}
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&WatchResultsResponse{ResumeToken: rw.token}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-w.done:
+			return w.err
+		case <-w.ids:
+			// A poll reads everything written since the last one, so
+			// it covers the notifications that queued up meanwhile.
+			for len(w.ids) > 0 {
+				<-w.ids
+			}
+			if xmin, err = currentXmin(db); err != nil {
+				return toStatusError(err)
+			}
+			if err := rw.poll(xmin, true, "results.xid >= ?", rw.xmin); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -11,8 +11,17 @@ import (
 func ParseConfig(configDir, configFile string) *viper.Viper {
 	viper.SetDefault("app.host", "localhost")
 	viper.SetDefault("app.port", "50051")
+	viper.SetDefault("app.http_port", "8080")
+	// OSCAL documents, like the NIST SP 800-53 catalog, are larger than the
+	// default gRPC message size limit of 4 MB.
+	viper.SetDefault("app.max_message_size", 64*1024*1024)
+	viper.SetDefault("app.health_check_interval", "10s")
+	viper.SetDefault("app.reflection", false)
 	viper.SetDefault("database.port", "5432")
 	viper.SetDefault("database.name", "compliance")
+	viper.SetDefault("evidence.backend", "filesystem")
+	viper.SetDefault("evidence.path", "evidence")
+	viper.SetDefault("evidence.max_size", 100*1024*1024)
 	configType := "yaml"
 	parts := strings.Split(configFile, ".")
 	if ln := len(parts); ln > 1 {
@@ -55,6 +64,9 @@ func validateConfig(v *viper.Viper) {
 	if v.GetString("database.username") == "" {
 		log.Fatal("Database username not provided (database.username)")
 	}
+	if v.GetDuration("app.health_check_interval") <= 0 {
+		log.Fatal("Health check interval must be a positive duration (app.health_check_interval)")
+	}
 
 	p := v.GetString("database.password.provider")
 	switch p {
@@ -75,4 +87,21 @@ func validateConfig(v *viper.Viper) {
 	default:
 		log.Fatalf("Invalid password provider: %s", p)
 	}
+
+	if v.GetInt64("evidence.max_size") <= 0 {
+		log.Fatal("Maximum evidence size must be positive (evidence.max_size)")
+	}
+	b := v.GetString("evidence.backend")
+	switch b {
+	case "filesystem":
+		if v.GetString("evidence.path") == "" {
+			log.Fatal("Evidence directory not provided (evidence.path)")
+		}
+	case "s3":
+		if v.GetString("evidence.s3.bucket") == "" {
+			log.Fatal("Evidence bucket not provided (evidence.s3.bucket)")
+		}
+	default:
+		log.Fatalf("Invalid evidence backend: %s", b)
+	}
 }
@@ -0,0 +1,27 @@
+package compserv
+
+import (
+	"fmt"
+
+	evidence "github.com/rhmdnd/compserv/pkg/evidence"
+	"github.com/spf13/viper"
+)
+
+// GetEvidenceStore returns the store evidence uploaded to the service is
+// kept in, as configured by evidence.backend.
+func GetEvidenceStore(v *viper.Viper) (evidence.Store, error) {
+	switch b := v.GetString("evidence.backend"); b {
+	case "filesystem":
+		return evidence.NewFileStore(v.GetString("evidence.path"))
+	case "s3":
+		return evidence.NewS3Store(evidence.S3Config{
+			Bucket:         v.GetString("evidence.s3.bucket"),
+			Prefix:         v.GetString("evidence.s3.prefix"),
+			Region:         v.GetString("evidence.s3.region"),
+			Endpoint:       v.GetString("evidence.s3.endpoint"),
+			ForcePathStyle: v.GetBool("evidence.s3.force_path_style"),
+		})
+	default:
+		return nil, fmt.Errorf("invalid evidence backend: %s", b)
+	}
+}
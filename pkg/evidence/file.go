@@ -0,0 +1,90 @@
+package compserv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FileStore keeps evidence as files in a directory on the local filesystem.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a store for the directory, creating it if it doesn't
+// exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create evidence directory %s: %w", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Put writes the contents to a temporary file first and renames it once
+// they're complete, so readers never see part of a file.
+func (s *FileStore) Put(ctx context.Context, key, _ string, r io.Reader) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create evidence file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := io.Copy(f, &contextReader{ctx: ctx, r: r}); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("failed to write evidence file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write evidence file: %w", err)
+	}
+	if err := os.Rename(f.Name(), filepath.Join(s.dir, key)); err != nil {
+		return fmt.Errorf("failed to store evidence %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *FileStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(s.dir, key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open evidence %s: %w", key, err)
+	}
+	return f, nil
+}
+
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(s.dir, key))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete evidence %s: %w", key, err)
+	}
+	return nil
+}
+
+// contextReader stops reading once the context is done, so uploads that
+// are canceled don't keep writing.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
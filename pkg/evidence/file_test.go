@@ -0,0 +1,74 @@
+package compserv
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("Unable to create file store: %s", err)
+	}
+	ctx := context.Background()
+	key := "0f9a4c1e-7d2b-4e5f-8a3c-6b1d2e3f4a5b"
+
+	if _, err := s.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected missing evidence to be not found, got %v", err)
+	}
+	if err := s.Put(ctx, key, "text/plain", strings.NewReader("sshd_config: PermitRootLogin no")); err != nil {
+		t.Fatalf("Unable to put evidence: %s", err)
+	}
+	r, err := s.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Unable to get evidence: %s", err)
+	}
+	contents, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatalf("Unable to read evidence: %s", err)
+	}
+	assert.Equal(t, "sshd_config: PermitRootLogin no", string(contents))
+
+	// Failed uploads shouldn't leave anything behind.
+	failure := errors.New("connection reset")
+	err = s.Put(ctx, "failed", "text/plain", io.MultiReader(strings.NewReader("part"), &errReader{failure}))
+	if !errors.Is(err, failure) {
+		t.Fatalf("Expected upload to fail with %v, got %v", failure, err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Unable to read evidence directory: %s", err)
+	}
+	assert.Equal(t, 1, len(entries), "expected only %s in the evidence directory", key)
+
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("Unable to delete evidence: %s", err)
+	}
+	if _, err := s.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected deleted evidence to be not found, got %v", err)
+	}
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("Expected deleting missing evidence to succeed, got %v", err)
+	}
+
+	for _, k := range []string{"", "../outside", "a/b", ".upload-1"} {
+		assert.Error(t, s.Put(ctx, k, "text/plain", strings.NewReader("x")), "expected key %q to be rejected", k)
+	}
+}
+
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
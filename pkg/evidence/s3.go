@@ -0,0 +1,106 @@
+package compserv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// S3Config locates the bucket an S3Store keeps evidence in. The endpoint
+// and path style addressing are only needed for S3 compatible services,
+// like MinIO. Credentials are found like they are by the AWS CLI.
+type S3Config struct {
+	Bucket string
+	// Prefix is prepended to the key of every object, like evidence/.
+	Prefix         string
+	Region         string
+	Endpoint       string
+	ForcePathStyle bool
+}
+
+// S3Store keeps evidence as objects in an S3 bucket.
+type S3Store struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+func NewS3Store(c S3Config) (*S3Store, error) {
+	cfg := aws.NewConfig().WithS3ForcePathStyle(c.ForcePathStyle)
+	if c.Region != "" {
+		cfg = cfg.WithRegion(c.Region)
+	}
+	if c.Endpoint != "" {
+		cfg = cfg.WithEndpoint(c.Endpoint)
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+	client := s3.New(sess)
+	return &S3Store{
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+		bucket:   c.Bucket,
+		prefix:   c.Prefix,
+	}, nil
+}
+
+// Put uploads the contents in parts, so they don't have to fit in memory.
+// Parts of a failed upload are removed.
+func (s *S3Store) Put(ctx context.Context, key, contentType string, r io.Reader) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	input := &s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+		Body:   r,
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	if _, err := s.uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("failed to upload evidence %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+	})
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to download evidence %s: %w", key, err)
+	}
+	return out.Body, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete evidence %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package compserv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+// ErrNotFound is returned by stores for keys they don't have.
+var ErrNotFound = errors.New("evidence not found")
+
+// Store keeps the contents of evidence attached to results and assessments,
+// which can be too large for the database. The service only stores the
+// metadata of evidence, like its digest, and keys the contents by the ID of
+// the evidence.
+type Store interface {
+	// Put stores the contents read from r under a key, replacing anything
+	// already stored under it. Nothing is stored if r returns an error.
+	Put(ctx context.Context, key, contentType string, r io.Reader) error
+	// Get returns a reader for the contents stored under a key, which the
+	// caller must close.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the contents stored under a key. Deleting a key that
+	// doesn't exist isn't an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// keyPattern matches the keys stores accept. Keys are used as file and
+// object names, so they're limited to characters that are safe in both.
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+func checkKey(key string) error {
+	if len(key) > 255 || !keyPattern.MatchString(key) {
+		return fmt.Errorf("invalid evidence key %q", key)
+	}
+	return nil
+}
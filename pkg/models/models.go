@@ -0,0 +1,200 @@
+package compserv
+
+import (
+	"database/sql"
+	"time"
+)
+
+// The following types map to the tables created by the migrations in
+// migrations/. They're intentionally kept simple so they reflect the schema
+// as closely as possible.
+
+type Metadata struct {
+	ID          string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     sql.NullString
+	Description sql.NullString
+}
+
+type Subject struct {
+	ID         string
+	Name       string
+	Type       sql.NullString
+	ParentID   sql.NullString
+	MetadataID sql.NullString
+}
+
+// SubjectLabel is a key/value pair a subject is tagged with.
+type SubjectLabel struct {
+	SubjectID string
+	Key       string
+	Value     string
+}
+
+type Catalog struct {
+	ID         string
+	Name       string
+	MetadataID sql.NullString
+	Content    sql.NullString
+	Version    sql.NullString
+}
+
+type Profile struct {
+	ID         string
+	Name       string
+	MetadataID sql.NullString
+	CatalogID  sql.NullString
+	Version    sql.NullString
+	Content    sql.NullString
+	Parameters sql.NullString
+}
+
+type ProfileCatalog struct {
+	ProfileID string
+	CatalogID string
+	Href      sql.NullString
+}
+
+type Control struct {
+	ID         string
+	Name       string
+	Severity   sql.NullString
+	ProfileID  sql.NullString
+	MetadataID sql.NullString
+	CatalogID  sql.NullString
+	OscalID    sql.NullString
+	Title      sql.NullString
+	// ParentID references the control an enhancement enhances.
+	ParentID sql.NullString
+	FamilyID sql.NullString
+	// Position orders controls as they appear in their catalog.
+	Position sql.NullInt32
+}
+
+// ControlFamily is a group of controls in a catalog, like Access Control.
+type ControlFamily struct {
+	ID        string
+	CatalogID string
+	OscalID   string
+	Title     sql.NullString
+	Position  int
+}
+
+// ControlMapping crosswalks a control of one catalog to a control of another.
+type ControlMapping struct {
+	ID              string
+	SourceControlID string
+	TargetControlID string
+	Relationship    string
+}
+
+type Rule struct {
+	ID            string
+	Name          string
+	Title         sql.NullString
+	Description   sql.NullString
+	Severity      sql.NullString
+	Rationale     sql.NullString
+	CheckMetadata sql.NullString
+}
+
+// RuleControl maps a rule to a control it provides evidence for.
+type RuleControl struct {
+	RuleID    string
+	ControlID string
+}
+
+type Assessment struct {
+	ID         string
+	Name       sql.NullString
+	MetadataID sql.NullString
+	State      string
+	StartedAt  sql.NullTime
+	FinishedAt sql.NullTime
+}
+
+type Result struct {
+	ID           string
+	Name         string
+	Outcome      string
+	Instruction  sql.NullString
+	Rationale    sql.NullString
+	ControlID    sql.NullString
+	MetadataID   sql.NullString
+	SubjectID    sql.NullString
+	AssessmentID sql.NullString
+	RuleID       sql.NullString
+	// IdempotencyKey is supplied by clients so retries don't create
+	// duplicate results. RequestHash identifies the request that used it.
+	IdempotencyKey sql.NullString
+	RequestHash    sql.NullString
+	// Seq is assigned by the database when the result is inserted.
+	Seq int64 `gorm:"->"`
+}
+
+// Exception accepts failures of a control or rule on a subject and its
+// descendants until it expires or is revoked. Exactly one of ControlID and
+// RuleID is set.
+type Exception struct {
+	ID            string
+	SubjectID     string
+	ControlID     sql.NullString
+	RuleID        sql.NullString
+	Justification string
+	Approver      string
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+	RevokedAt     sql.NullTime
+}
+
+// Evidence describes a file attached to a result or an assessment. The
+// contents are kept in a blob store under the ID. Exactly one of ResultID
+// and AssessmentID is set.
+type Evidence struct {
+	ID           string
+	ResultID     sql.NullString
+	AssessmentID sql.NullString
+	Name         string
+	ContentType  string
+	// SHA256 is the hex-encoded digest of the contents.
+	SHA256    string
+	Size      int64
+	CreatedAt time.Time
+}
+
+func (Evidence) TableName() string {
+	return "evidence"
+}
+
+type DailyComplianceRollup struct {
+	Day       time.Time
+	SubjectID string
+	ControlID string
+	Outcome   string
+	Count     int64
+}
+
+type StaleRollupDay struct {
+	Day      time.Time
+	MarkedAt time.Time
+}
+
+// ResultHistory holds the previous values of a result that was reported
+// again during the same assessment.
+type ResultHistory struct {
+	ID             int64
+	ResultID       string
+	Outcome        string
+	Instruction    sql.NullString
+	Rationale      sql.NullString
+	ControlID      sql.NullString
+	MetadataID     sql.NullString
+	IdempotencyKey sql.NullString
+	RequestHash    sql.NullString
+	ReplacedAt     time.Time
+}
+
+func (ResultHistory) TableName() string {
+	return "result_history"
+}
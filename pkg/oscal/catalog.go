@@ -0,0 +1,149 @@
+package compserv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The following types cover the parts of the OSCAL catalog model we store in
+// the database. See https://pages.nist.gov/OSCAL/reference/latest/catalog/
+// for the complete model. Fields we don't need are ignored when decoding.
+
+type CatalogDocument struct {
+	Catalog Catalog `json:"catalog"`
+}
+
+type Catalog struct {
+	UUID     string    `json:"uuid"`
+	Metadata Metadata  `json:"metadata"`
+	Groups   []Group   `json:"groups,omitempty"`
+	Controls []Control `json:"controls,omitempty"`
+}
+
+type Metadata struct {
+	Title        string `json:"title"`
+	Version      string `json:"version"`
+	OscalVersion string `json:"oscal-version"`
+	LastModified string `json:"last-modified,omitempty"`
+	Remarks      string `json:"remarks,omitempty"`
+}
+
+type Group struct {
+	ID       string    `json:"id,omitempty"`
+	Class    string    `json:"class,omitempty"`
+	Title    string    `json:"title"`
+	Props    []Prop    `json:"props,omitempty"`
+	Groups   []Group   `json:"groups,omitempty"`
+	Controls []Control `json:"controls,omitempty"`
+}
+
+type Control struct {
+	ID       string    `json:"id"`
+	Class    string    `json:"class,omitempty"`
+	Title    string    `json:"title"`
+	Props    []Prop    `json:"props,omitempty"`
+	Controls []Control `json:"controls,omitempty"`
+}
+
+type Prop struct {
+	Name  string `json:"name"`
+	NS    string `json:"ns,omitempty"`
+	Value string `json:"value"`
+	Class string `json:"class,omitempty"`
+}
+
+// FlatControl is a control, or control enhancement, along with its position
+// in the catalog.
+type FlatControl struct {
+	ID    string
+	Label string
+	Title string
+	// ParentID is the ID of the control this control enhances, if any.
+	ParentID string
+	// GroupID is the ID of the innermost group containing the control,
+	// like the control family.
+	GroupID    string
+	GroupTitle string
+	// Position is the order the control appears in the catalog.
+	Position int
+}
+
+// ParseCatalog decodes an OSCAL catalog in JSON format and makes sure it has
+// the information we need to store it.
+func ParseCatalog(content []byte) (*Catalog, error) {
+	doc := CatalogDocument{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode OSCAL catalog: %w", err)
+	}
+	c := &doc.Catalog
+	if c.UUID == "" {
+		return nil, errors.New("catalog is missing a uuid")
+	}
+	if c.Metadata.Title == "" {
+		return nil, errors.New("catalog is missing a metadata title")
+	}
+	if c.Metadata.Version == "" {
+		return nil, errors.New("catalog is missing a metadata version")
+	}
+	return c, nil
+}
+
+// Flatten returns every control and control enhancement in the catalog in
+// document order.
+func (c *Catalog) Flatten() []FlatControl {
+	var controls []FlatControl
+	var walkControls func(cs []Control, parentID string, g *Group)
+	walkControls = func(cs []Control, parentID string, g *Group) {
+		for _, ctl := range cs {
+			f := FlatControl{
+				ID:       ctl.ID,
+				Label:    ctl.Label(),
+				Title:    ctl.Title,
+				ParentID: parentID,
+				Position: len(controls),
+			}
+			if g != nil {
+				f.GroupID = g.ID
+				f.GroupTitle = g.Title
+			}
+			controls = append(controls, f)
+			walkControls(ctl.Controls, ctl.ID, g)
+		}
+	}
+	var walkGroups func(gs []Group)
+	walkGroups = func(gs []Group) {
+		for i := range gs {
+			walkControls(gs[i].Controls, "", &gs[i])
+			walkGroups(gs[i].Groups)
+		}
+	}
+	walkControls(c.Controls, "", nil)
+	walkGroups(c.Groups)
+	return controls
+}
+
+// Label returns the human readable identifier of the control, like AC-2(1).
+// Catalogs don't always include labels, so fall back to the control ID.
+func (c *Control) Label() string {
+	label := ""
+	for _, p := range c.Props {
+		if p.Name != "label" {
+			continue
+		}
+		// NIST catalogs include additional labels, like zero-padded
+		// identifiers, distinguished by a class. Prefer the label
+		// without one.
+		if p.Class == "" {
+			return p.Value
+		}
+		if label == "" {
+			label = p.Value
+		}
+	}
+	if label != "" {
+		return label
+	}
+	return strings.ToUpper(c.ID)
+}
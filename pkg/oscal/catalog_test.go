@@ -0,0 +1,44 @@
+package compserv
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCatalog(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/catalog.json")
+	if err != nil {
+		t.Fatalf("Unable to read test catalog: %s", err)
+	}
+	c, err := ParseCatalog(content)
+	if err != nil {
+		t.Fatalf("Unable to parse catalog: %s", err)
+	}
+	assert.Equal(t, "Example Security and Privacy Controls", c.Metadata.Title)
+	assert.Equal(t, "5.1.1", c.Metadata.Version)
+
+	controls := c.Flatten()
+	expected := []FlatControl{
+		{ID: "ac-1", Label: "AC-1", Title: "Policy and Procedures", GroupID: "ac", GroupTitle: "Access Control", Position: 0},
+		{ID: "ac-2", Label: "AC-2", Title: "Account Management", GroupID: "ac", GroupTitle: "Access Control", Position: 1},
+		{
+			ID: "ac-2.1", Label: "AC-2(1)", Title: "Automated System Account Management", ParentID: "ac-2",
+			GroupID: "ac", GroupTitle: "Access Control", Position: 2,
+		},
+		{ID: "au-2", Label: "AU-2", Title: "Event Logging", GroupID: "au", GroupTitle: "Audit and Accountability", Position: 3},
+	}
+	assert.Equal(t, expected, controls)
+}
+
+func TestParseCatalogWithoutVersionFails(t *testing.T) {
+	t.Parallel()
+	content := []byte(`{"catalog": {"uuid": "7a8d1f0e-3f1c-4a38-9b8c-5b0d2f2f6f11", "metadata": {"title": "Example"}}}`)
+	_, err := ParseCatalog(content)
+	assert.NotNil(t, err)
+
+	_, err = ParseCatalog([]byte("not json"))
+	assert.NotNil(t, err)
+}
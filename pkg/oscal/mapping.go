@@ -0,0 +1,136 @@
+package compserv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// The following types cover the parts of the OSCAL mapping model we need to
+// crosswalk controls between catalogs. See
+// https://pages.nist.gov/OSCAL/reference/latest/mapping/ for the complete
+// model.
+
+type MappingCollectionDocument struct {
+	MappingCollection MappingCollection `json:"mapping-collection"`
+}
+
+type MappingCollection struct {
+	UUID     string    `json:"uuid"`
+	Metadata Metadata  `json:"metadata"`
+	Mappings []Mapping `json:"mappings"`
+}
+
+type Mapping struct {
+	UUID           string          `json:"uuid"`
+	SourceResource MappingResource `json:"source-resource"`
+	TargetResource MappingResource `json:"target-resource"`
+	Maps           []Map           `json:"maps"`
+}
+
+type MappingResource struct {
+	Type string `json:"type"`
+	Href string `json:"href"`
+}
+
+type Map struct {
+	UUID         string    `json:"uuid,omitempty"`
+	Relationship string    `json:"relationship"`
+	Sources      []MapItem `json:"sources"`
+	Targets      []MapItem `json:"targets"`
+}
+
+type MapItem struct {
+	Type  string `json:"type"`
+	IDRef string `json:"id-ref"`
+}
+
+// These are the relationships a map can describe between its sources and
+// targets.
+const (
+	RelationshipEquivalentTo   = "equivalent-to"
+	RelationshipEqualTo        = "equal-to"
+	RelationshipSubsetOf       = "subset-of"
+	RelationshipSupersetOf     = "superset-of"
+	RelationshipIntersectsWith = "intersects-with"
+	RelationshipNone           = "no-relationship"
+)
+
+var relationships = map[string]bool{
+	RelationshipEquivalentTo:   true,
+	RelationshipEqualTo:        true,
+	RelationshipSubsetOf:       true,
+	RelationshipSupersetOf:     true,
+	RelationshipIntersectsWith: true,
+	RelationshipNone:           true,
+}
+
+// FlatMapping relates a control of the source catalog to a control of the
+// target catalog.
+type FlatMapping struct {
+	SourceHref   string
+	TargetHref   string
+	Source       string
+	Target       string
+	Relationship string
+}
+
+// ParseMappingCollection decodes an OSCAL mapping collection in JSON format
+// and makes sure every map relates controls.
+func ParseMappingCollection(content []byte) (*MappingCollection, error) {
+	doc := MappingCollectionDocument{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode OSCAL mapping collection: %w", err)
+	}
+	mc := &doc.MappingCollection
+	if mc.UUID == "" {
+		return nil, errors.New("mapping collection is missing a uuid")
+	}
+	if len(mc.Mappings) == 0 {
+		return nil, errors.New("mapping collection doesn't include any mappings")
+	}
+	for _, m := range mc.Mappings {
+		if m.SourceResource.Href == "" || m.TargetResource.Href == "" {
+			return nil, fmt.Errorf("mapping %s is missing a source or target resource", m.UUID)
+		}
+		for _, mp := range m.Maps {
+			if !relationships[mp.Relationship] {
+				return nil, fmt.Errorf("map %s has unknown relationship %q", mp.UUID, mp.Relationship)
+			}
+			for _, item := range append(append([]MapItem{}, mp.Sources...), mp.Targets...) {
+				if item.Type != "control" {
+					return nil, fmt.Errorf("map %s references a %s, only controls are supported", mp.UUID, item.Type)
+				}
+				if item.IDRef == "" {
+					return nil, fmt.Errorf("map %s references a control without an id-ref", mp.UUID)
+				}
+			}
+		}
+	}
+	return mc, nil
+}
+
+// Flatten returns a mapping for every source and target control pair in the
+// collection. Maps stating the controls have no relationship are skipped.
+func (mc *MappingCollection) Flatten() []FlatMapping {
+	var mappings []FlatMapping
+	for _, m := range mc.Mappings {
+		for _, mp := range m.Maps {
+			if mp.Relationship == RelationshipNone {
+				continue
+			}
+			for _, s := range mp.Sources {
+				for _, t := range mp.Targets {
+					mappings = append(mappings, FlatMapping{
+						SourceHref:   m.SourceResource.Href,
+						TargetHref:   m.TargetResource.Href,
+						Source:       s.IDRef,
+						Target:       t.IDRef,
+						Relationship: mp.Relationship,
+					})
+				}
+			}
+		}
+	}
+	return mappings
+}
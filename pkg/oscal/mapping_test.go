@@ -0,0 +1,60 @@
+package compserv
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMappingCollection(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/mapping.json")
+	if err != nil {
+		t.Fatalf("Unable to read test mapping collection: %s", err)
+	}
+	mc, err := ParseMappingCollection(content)
+	if err != nil {
+		t.Fatalf("Unable to parse mapping collection: %s", err)
+	}
+	assert.Equal(t, "Example Benchmark to SP 800-53 Mapping", mc.Metadata.Title)
+
+	source := "https://example.com/benchmark.json"
+	target := "https://example.com/catalog.json"
+	expected := []FlatMapping{
+		{SourceHref: source, TargetHref: target, Source: "5.1.1", Target: "ac-2", Relationship: RelationshipEquivalentTo},
+		{SourceHref: source, TargetHref: target, Source: "5.1.2", Target: "ac-2.1", Relationship: RelationshipSubsetOf},
+		{SourceHref: source, TargetHref: target, Source: "5.1.2", Target: "au-2", Relationship: RelationshipSubsetOf},
+	}
+	assert.Equal(t, expected, mc.Flatten())
+}
+
+func TestParseMappingCollectionWithStatementsFails(t *testing.T) {
+	t.Parallel()
+	content := []byte(`{"mapping-collection": {"uuid": "3e1f6c2a-9b4d-4f7e-8a15-2c6d9e0b4a71", "mappings": [{
+		"source-resource": {"type": "catalog", "href": "a.json"},
+		"target-resource": {"type": "catalog", "href": "b.json"},
+		"maps": [{
+			"relationship": "equivalent-to",
+			"sources": [{"type": "statement", "id-ref": "ac-2_smt.a"}],
+			"targets": [{"type": "control", "id-ref": "1.1"}]
+		}]
+	}]}}`)
+	_, err := ParseMappingCollection(content)
+	assert.NotNil(t, err)
+}
+
+func TestParseMappingCollectionWithUnknownRelationshipFails(t *testing.T) {
+	t.Parallel()
+	content := []byte(`{"mapping-collection": {"uuid": "3e1f6c2a-9b4d-4f7e-8a15-2c6d9e0b4a71", "mappings": [{
+		"source-resource": {"type": "catalog", "href": "a.json"},
+		"target-resource": {"type": "catalog", "href": "b.json"},
+		"maps": [{
+			"relationship": "similar-to",
+			"sources": [{"type": "control", "id-ref": "ac-2"}],
+			"targets": [{"type": "control", "id-ref": "1.1"}]
+		}]
+	}]}}`)
+	_, err := ParseMappingCollection(content)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,217 @@
+package compserv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// The following types cover the parts of the OSCAL profile model we need to
+// resolve a profile against catalogs. See
+// https://pages.nist.gov/OSCAL/reference/latest/profile/ for the complete
+// model.
+
+type ProfileDocument struct {
+	Profile Profile `json:"profile"`
+}
+
+type Profile struct {
+	UUID       string      `json:"uuid"`
+	Metadata   Metadata    `json:"metadata"`
+	Imports    []Import    `json:"imports"`
+	Modify     *Modify     `json:"modify,omitempty"`
+	BackMatter *BackMatter `json:"back-matter,omitempty"`
+}
+
+type Import struct {
+	Href            string             `json:"href"`
+	IncludeAll      *struct{}          `json:"include-all,omitempty"`
+	IncludeControls []ControlSelection `json:"include-controls,omitempty"`
+	ExcludeControls []ControlSelection `json:"exclude-controls,omitempty"`
+}
+
+type ControlSelection struct {
+	WithChildControls string         `json:"with-child-controls,omitempty"`
+	WithIDs           []string       `json:"with-ids,omitempty"`
+	Matching          []MatchPattern `json:"matching,omitempty"`
+}
+
+type MatchPattern struct {
+	Pattern string `json:"pattern"`
+}
+
+type Modify struct {
+	SetParameters []SetParameter `json:"set-parameters,omitempty"`
+}
+
+type SetParameter struct {
+	ParamID string   `json:"param-id"`
+	Values  []string `json:"values,omitempty"`
+}
+
+type BackMatter struct {
+	Resources []Resource `json:"resources,omitempty"`
+}
+
+type Resource struct {
+	UUID        string  `json:"uuid"`
+	Title       string  `json:"title,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Rlinks      []Rlink `json:"rlinks,omitempty"`
+}
+
+type Rlink struct {
+	Href string `json:"href"`
+}
+
+// ParseProfile decodes an OSCAL profile in JSON format and makes sure it has
+// the information we need to resolve it.
+func ParseProfile(content []byte) (*Profile, error) {
+	doc := ProfileDocument{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode OSCAL profile: %w", err)
+	}
+	p := &doc.Profile
+	if p.UUID == "" {
+		return nil, errors.New("profile is missing a uuid")
+	}
+	if p.Metadata.Title == "" {
+		return nil, errors.New("profile is missing a metadata title")
+	}
+	if p.Metadata.Version == "" {
+		return nil, errors.New("profile is missing a metadata version")
+	}
+	if len(p.Imports) == 0 {
+		return nil, errors.New("profile doesn't import any catalogs")
+	}
+	for _, i := range p.Imports {
+		if i.IncludeAll == nil && len(i.IncludeControls) == 0 {
+			return nil, fmt.Errorf("import %s must include all controls or specific controls", i.Href)
+		}
+	}
+	return p, nil
+}
+
+// ImportHrefs returns the references that can be used to identify the
+// source of an import. Imports commonly point to a resource in the back
+// matter of the profile (e.g., #<uuid>), in which case the links of the
+// resource are included after the original reference.
+func (p *Profile) ImportHrefs(i *Import) []string {
+	hrefs := []string{i.Href}
+	if !strings.HasPrefix(i.Href, "#") || p.BackMatter == nil {
+		return hrefs
+	}
+	id := strings.TrimPrefix(i.Href, "#")
+	for _, r := range p.BackMatter.Resources {
+		if r.UUID != id {
+			continue
+		}
+		for _, l := range r.Rlinks {
+			hrefs = append(hrefs, l.Href)
+		}
+	}
+	return hrefs
+}
+
+// Parameters returns the parameter values set by the profile keyed by
+// parameter ID.
+func (p *Profile) Parameters() map[string][]string {
+	params := map[string][]string{}
+	if p.Modify == nil {
+		return params
+	}
+	for _, sp := range p.Modify.SetParameters {
+		params[sp.ParamID] = sp.Values
+	}
+	return params
+}
+
+// Select returns the catalog controls selected by an import, in catalog
+// order. The controls must come from Catalog.Flatten so control
+// enhancements can be matched to their parent controls.
+func (i *Import) Select(controls []FlatControl) ([]FlatControl, error) {
+	children := map[string][]string{}
+	for _, c := range controls {
+		if c.ParentID != "" {
+			children[c.ParentID] = append(children[c.ParentID], c.ID)
+		}
+	}
+
+	included := map[string]bool{}
+	if i.IncludeAll != nil {
+		for _, c := range controls {
+			included[c.ID] = true
+		}
+	}
+	for _, s := range i.IncludeControls {
+		ids, err := s.resolve(controls, children)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			included[id] = true
+		}
+	}
+	for _, s := range i.ExcludeControls {
+		ids, err := s.resolve(controls, children)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			delete(included, id)
+		}
+	}
+
+	var selected []FlatControl
+	for _, c := range controls {
+		if included[c.ID] {
+			selected = append(selected, c)
+		}
+	}
+	return selected, nil
+}
+
+// resolve returns the IDs of the controls matched by the selection.
+func (s *ControlSelection) resolve(controls []FlatControl, children map[string][]string) ([]string, error) {
+	known := map[string]bool{}
+	for _, c := range controls {
+		known[c.ID] = true
+	}
+
+	var ids []string
+	for _, id := range s.WithIDs {
+		if !known[id] {
+			return nil, fmt.Errorf("control %s does not exist in the imported catalog", id)
+		}
+		ids = append(ids, id)
+	}
+	for _, m := range s.Matching {
+		for _, c := range controls {
+			ok, err := path.Match(m.Pattern, c.ID)
+			if err != nil {
+				return nil, fmt.Errorf("invalid control pattern %s: %w", m.Pattern, err)
+			}
+			if ok {
+				ids = append(ids, c.ID)
+			}
+		}
+	}
+	if s.WithChildControls != "yes" {
+		return ids, nil
+	}
+
+	var withChildren []string
+	var walk func(id string)
+	walk = func(id string) {
+		withChildren = append(withChildren, id)
+		for _, child := range children[id] {
+			walk(child)
+		}
+	}
+	for _, id := range ids {
+		walk(id)
+	}
+	return withChildren, nil
+}
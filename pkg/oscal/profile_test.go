@@ -0,0 +1,76 @@
+package compserv
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProfile(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/profile.json")
+	if err != nil {
+		t.Fatalf("Unable to read test profile: %s", err)
+	}
+	p, err := ParseProfile(content)
+	if err != nil {
+		t.Fatalf("Unable to parse profile: %s", err)
+	}
+	assert.Equal(t, "Example Moderate Baseline", p.Metadata.Title)
+	assert.Equal(t, "1.0.0", p.Metadata.Version)
+	assert.Equal(t, map[string][]string{"ac-2_prm_1": {"at least annually"}}, p.Parameters())
+
+	expected := []string{"#5c9b7e3a-1f2d-4b6c-8a9e-0d3f4e5a6b7c", "https://example.com/catalog.json"}
+	assert.Equal(t, expected, p.ImportHrefs(&p.Imports[0]))
+}
+
+func TestImportSelect(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("testdata/catalog.json")
+	if err != nil {
+		t.Fatalf("Unable to read test catalog: %s", err)
+	}
+	c, err := ParseCatalog(content)
+	if err != nil {
+		t.Fatalf("Unable to parse catalog: %s", err)
+	}
+	controls := c.Flatten()
+
+	content, err = os.ReadFile("testdata/profile.json")
+	if err != nil {
+		t.Fatalf("Unable to read test profile: %s", err)
+	}
+	p, err := ParseProfile(content)
+	if err != nil {
+		t.Fatalf("Unable to parse profile: %s", err)
+	}
+	selected, err := p.Imports[0].Select(controls)
+	if err != nil {
+		t.Fatalf("Unable to select controls: %s", err)
+	}
+	ids := []string{}
+	for _, c := range selected {
+		ids = append(ids, c.ID)
+	}
+	assert.Equal(t, []string{"ac-2", "ac-2.1"}, ids)
+
+	all := Import{IncludeAll: &struct{}{}, ExcludeControls: []ControlSelection{{WithIDs: []string{"ac-1"}}}}
+	selected, err = all.Select(controls)
+	if err != nil {
+		t.Fatalf("Unable to select controls: %s", err)
+	}
+	assert.Equal(t, 3, len(selected))
+
+	missing := Import{IncludeControls: []ControlSelection{{WithIDs: []string{"zz-1"}}}}
+	_, err = missing.Select(controls)
+	assert.NotNil(t, err)
+}
+
+func TestParseProfileWithoutSelectionFails(t *testing.T) {
+	t.Parallel()
+	content := []byte(`{"profile": {"uuid": "0b3c1f5e-8d7a-4c2e-9f41-6a2d8e5b7c90",
+		"metadata": {"title": "Example", "version": "1.0.0"}, "imports": [{"href": "#catalog"}]}}`)
+	_, err := ParseProfile(content)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,147 @@
+package compserv
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The following types cover the parts of the OSCAL assessment results model
+// we export. See https://pages.nist.gov/OSCAL/reference/latest/assessment-results/
+// for the complete model.
+
+// Version is the OSCAL version of the documents we produce.
+const Version = "1.1.2"
+
+type AssessmentResultsDocument struct {
+	AssessmentResults AssessmentResults `json:"assessment-results"`
+}
+
+type AssessmentResults struct {
+	UUID       string      `json:"uuid"`
+	Metadata   Metadata    `json:"metadata"`
+	ImportAP   ImportAP    `json:"import-ap"`
+	Results    []Result    `json:"results"`
+	BackMatter *BackMatter `json:"back-matter,omitempty"`
+}
+
+// ImportAP references the assessment plan the results are for.
+type ImportAP struct {
+	Href string `json:"href"`
+}
+
+type Result struct {
+	UUID             string            `json:"uuid"`
+	Title            string            `json:"title"`
+	Description      string            `json:"description"`
+	Start            time.Time         `json:"start"`
+	End              *time.Time        `json:"end,omitempty"`
+	Props            []Prop            `json:"props,omitempty"`
+	LocalDefinitions *LocalDefinitions `json:"local-definitions,omitempty"`
+	ReviewedControls ReviewedControls  `json:"reviewed-controls"`
+	Observations     []Observation     `json:"observations,omitempty"`
+	Findings         []Finding         `json:"findings,omitempty"`
+}
+
+type LocalDefinitions struct {
+	InventoryItems []InventoryItem `json:"inventory-items,omitempty"`
+}
+
+type InventoryItem struct {
+	UUID        string `json:"uuid"`
+	Description string `json:"description"`
+	Props       []Prop `json:"props,omitempty"`
+}
+
+type ReviewedControls struct {
+	ControlSelections []ReviewedControlSelection `json:"control-selections"`
+}
+
+type ReviewedControlSelection struct {
+	Description     string              `json:"description,omitempty"`
+	IncludeControls []SelectControlByID `json:"include-controls,omitempty"`
+}
+
+type SelectControlByID struct {
+	ControlID string `json:"control-id"`
+}
+
+type Observation struct {
+	UUID        string             `json:"uuid"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description"`
+	Props       []Prop             `json:"props,omitempty"`
+	Methods     []string           `json:"methods"`
+	Subjects    []SubjectReference `json:"subjects,omitempty"`
+	Collected   time.Time          `json:"collected"`
+	Remarks     string             `json:"remarks,omitempty"`
+}
+
+type SubjectReference struct {
+	SubjectUUID string `json:"subject-uuid"`
+	Type        string `json:"type"`
+	Title       string `json:"title,omitempty"`
+}
+
+type Finding struct {
+	UUID                string               `json:"uuid"`
+	Title               string               `json:"title"`
+	Description         string               `json:"description"`
+	Target              FindingTarget        `json:"target"`
+	RelatedObservations []RelatedObservation `json:"related-observations,omitempty"`
+}
+
+type FindingTarget struct {
+	Type     string          `json:"type"`
+	TargetID string          `json:"target-id"`
+	Title    string          `json:"title,omitempty"`
+	Status   ObjectiveStatus `json:"status"`
+}
+
+type ObjectiveStatus struct {
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type RelatedObservation struct {
+	ObservationUUID string `json:"observation-uuid"`
+}
+
+// These are the states of a finding target.
+const (
+	StateSatisfied    = "satisfied"
+	StateNotSatisfied = "not-satisfied"
+)
+
+// These are the reasons for the state of a finding target.
+const (
+	ReasonPass  = "pass"
+	ReasonFail  = "fail"
+	ReasonOther = "other"
+)
+
+// Token converts a name to an OSCAL token, which identifiers like control
+// IDs must be. Names are lowercased and the parentheses of enhancements
+// become dots, so AC-2(1) becomes ac-2.1 like in NIST catalogs. Any other
+// character a token can't contain is replaced with an underscore.
+func Token(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r == '(':
+			b.WriteRune('.')
+		case r == ')':
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || r == '.' || r == '-' || r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	token := b.String()
+	// Tokens have to start with a letter or an underscore.
+	if r, _ := utf8.DecodeRuneInString(token); !unicode.IsLetter(r) && r != '_' {
+		token = "_" + token
+	}
+	return token
+}
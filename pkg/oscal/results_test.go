@@ -0,0 +1,21 @@
+package compserv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToken(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"ac-2":      "ac-2",
+		"AC-2(1)":   "ac-2.1",
+		"CIS 5.1.1": "cis_5.1.1",
+		"5.1.1":     "_5.1.1",
+		"":          "_",
+	}
+	for name, expected := range tests {
+		assert.Equal(t, expected, Token(name), "expected %s got %s", expected, Token(name))
+	}
+}
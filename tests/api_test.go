@@ -0,0 +1,2403 @@
+package tests // nolint:testpackage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/rhmdnd/compserv/pkg/api"
+	oscal "github.com/rhmdnd/compserv/pkg/oscal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// These tests exercise the gRPC service implementation directly against the
+// database. Like the migration tests, they need to run serially.
+
+func TestSetResultPersistsResult(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	s := api.NewServer(gormDB)
+
+	assessmentID, err := insertAssessment()
+	if err != nil {
+		t.Fatalf("Unable to create necessary assessment: %s", err)
+	}
+
+	r := &api.ResultRequest{
+		Subject:      clusterName,
+		Control:      "AC-2",
+		Rule:         "ocp4-cis-api-server-anonymous-auth",
+		AssessmentId: assessmentID,
+		Outcome:      "PASS",
+		Description:  "Ensure anonymous requests are authorized",
+		Severity:     "medium",
+		Instructions: "Run oc get apiserver cluster -o yaml",
+		Extra:        map[string]string{"scanner": "compliance-operator"},
+	}
+	response, err := s.SetResult(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	assert.NotEmpty(t, response.Id)
+
+	a := Result{}
+	err = gormDB.First(&a, "id = ?", response.Id).Error
+	assert.Nil(t, err)
+	assert.Equal(t, r.Rule, a.Name, "expected %s got %s", r.Rule, a.Name)
+	assert.Equal(t, r.Outcome, a.Outcome, "expected %s got %s", r.Outcome, a.Outcome)
+	assert.Equal(t, r.Instructions, a.Instruction, "expected %s got %s", r.Instructions, a.Instruction)
+	assert.Equal(t, r.Description, a.Rationale, "expected %s got %s", r.Description, a.Rationale)
+	assert.Equal(t, assessmentID, a.AssessmentID, "expected %s got %s", assessmentID, a.AssessmentID)
+
+	subject := Subject{}
+	gormDB.First(&subject, "id = ?", a.SubjectID)
+	assert.Equal(t, clusterName, subject.Name, "expected %s got %s", clusterName, subject.Name)
+
+	control := Control{}
+	gormDB.First(&control, "id = ?", a.ControlID)
+	assert.Equal(t, r.Control, control.Name, "expected %s got %s", r.Control, control.Name)
+	assert.Equal(t, r.Severity, control.Severity, "expected %s got %s", r.Severity, control.Severity)
+
+	// Submitting another result for the same subject and control should
+	// reuse the existing rows instead of creating new ones.
+	r.Rule = "ocp4-cis-api-server-basic-auth"
+	response, err = s.SetResult(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	b := Result{}
+	gormDB.First(&b, "id = ?", response.Id)
+	assert.Equal(t, a.SubjectID, b.SubjectID, "expected %s got %s", a.SubjectID, b.SubjectID)
+	assert.Equal(t, a.ControlID, b.ControlID, "expected %s got %s", a.ControlID, b.ControlID)
+
+	var subjects []Subject
+	result := gormDB.Find(&subjects)
+	assert.Equal(t, int64(1), result.RowsAffected, "expected %d got %d", 1, result.RowsAffected)
+}
+
+func TestSetResultWithUnknownAssessmentFails(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	s := api.NewServer(gormDB)
+
+	r := &api.ResultRequest{
+		Subject: clusterName, Control: "AC-2", Rule: "ocp4-cis-api-server-anonymous-auth",
+		AssessmentId: getUUIDString(), Outcome: "PASS",
+	}
+	_, err := s.SetResult(context.Background(), r)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+
+	// Nothing should be persisted if the request fails
+	var subjects []Subject
+	result := gormDB.Find(&subjects)
+	assert.Equal(t, int64(0), result.RowsAffected, "expected %d got %d", 0, result.RowsAffected)
+
+	r.AssessmentId = "1"
+	_, err = s.SetResult(context.Background(), r)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+
+	r.AssessmentId = ""
+	r.Subject = ""
+	_, err = s.SetResult(context.Background(), r)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+
+	// The error names the field to fix
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	assert.Equal(t, []string{"subject"}, fields, "expected %v got %v", []string{"subject"}, fields)
+}
+
+func TestSetResultsStreamsResults(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	client := getClientHelper(t)
+
+	assessmentID, err := insertAssessment()
+	if err != nil {
+		t.Fatalf("Unable to create necessary assessment: %s", err)
+	}
+
+	stream, err := client.SetResults(context.Background())
+	if err != nil {
+		t.Fatalf("Unable to open stream: %s", err)
+	}
+	requests := []*api.ResultRequest{
+		{Subject: clusterName, Control: "AC-2", Rule: getUUIDString(), AssessmentId: assessmentID, Outcome: "PASS"},
+		// Missing a rule
+		{Subject: clusterName, Control: "AC-2", AssessmentId: assessmentID, Outcome: "PASS"},
+		{Subject: clusterName, Control: "AC-6", Rule: getUUIDString(), AssessmentId: assessmentID, Outcome: "FAIL"},
+		// References an assessment that doesn't exist
+		{Subject: clusterName, Control: "AC-6", Rule: getUUIDString(), AssessmentId: getUUIDString(), Outcome: "FAIL"},
+	}
+	for _, r := range requests {
+		if err := stream.Send(r); err != nil {
+			t.Fatalf("Unable to send result: %s", err)
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("Unable to close stream: %s", err)
+	}
+
+	assert.Equal(t, int64(2), response.Accepted, "expected %d got %d", 2, response.Accepted)
+	assert.Equal(t, int64(2), response.Rejected, "expected %d got %d", 2, response.Rejected)
+	if assert.Len(t, response.Errors, 2) {
+		assert.Equal(t, int64(1), response.Errors[0].Index)
+		assert.Equal(t, int32(codes.InvalidArgument), response.Errors[0].Code)
+		assert.Equal(t, "rule", response.Errors[0].Field, "expected %s got %s", "rule", response.Errors[0].Field)
+		assert.Equal(t, int64(3), response.Errors[1].Index)
+		assert.Equal(t, int32(codes.InvalidArgument), response.Errors[1].Code)
+		assert.Equal(t, "assessmentId", response.Errors[1].Field,
+			"expected %s got %s", "assessmentId", response.Errors[1].Field)
+	}
+
+	var results []Result
+	result := gormDB.Find(&results)
+	assert.Equal(t, int64(2), result.RowsAffected, "expected %d got %d", 2, result.RowsAffected)
+}
+
+func TestSetResultIsIdempotent(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	client := getClientHelper(t)
+	ctx := context.Background()
+
+	key := getUUIDString()
+	request := &api.ResultRequest{
+		Subject: clusterName, Control: "AC-2", Rule: "rule-1", Outcome: "PASS",
+		Extra: map[string]string{"scanner": "a", "version": "1"}, IdempotencyKey: key,
+	}
+	first, err := client.SetResult(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	retry, err := client.SetResult(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to retry result: %s", err)
+	}
+	assert.Equal(t, first.Id, retry.Id, "expected %s got %s", first.Id, retry.Id)
+
+	// Reusing the key for a different result is an error
+	_, err = client.SetResult(ctx, &api.ResultRequest{
+		Subject: clusterName, Control: "AC-2", Rule: "rule-1", Outcome: "FAIL", IdempotencyKey: key,
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "expected %s got %s", codes.AlreadyExists, status.Code(err))
+
+	// Retries within a stream are accepted without creating results
+	stream, err := client.SetResults(ctx)
+	if err != nil {
+		t.Fatalf("Unable to open stream: %s", err)
+	}
+	other := &api.ResultRequest{
+		Subject: clusterName, Control: "AC-2", Rule: "rule-2", Outcome: "FAIL", IdempotencyKey: getUUIDString(),
+	}
+	for _, r := range []*api.ResultRequest{request, other, other} {
+		if err := stream.Send(r); err != nil {
+			t.Fatalf("Unable to send result: %s", err)
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("Unable to close stream: %s", err)
+	}
+	assert.Equal(t, int64(3), response.Accepted, "expected %d got %d", 3, response.Accepted)
+	assert.Equal(t, int64(0), response.Rejected, "expected %d got %d", 0, response.Rejected)
+
+	var results []Result
+	result := gormDB.Find(&results)
+	assert.Equal(t, int64(2), result.RowsAffected, "expected %d got %d", 2, result.RowsAffected)
+}
+
+func TestSetResultUpdatesAssessmentResult(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	s := api.NewServer(gormDB)
+	ctx := context.Background()
+
+	a, err := s.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "nightly scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	var ids []string
+	for _, outcome := range []string{"ERROR", "FAIL", "PASS"} {
+		r, err := s.SetResult(ctx, &api.ResultRequest{
+			Subject: clusterName, Control: "AC-2", Rule: "rule-1", Outcome: outcome, AssessmentId: a.Id,
+		})
+		if err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+		ids = append(ids, r.Id)
+	}
+	assert.Equal(t, []string{ids[0], ids[0], ids[0]}, ids, "expected %v got %v", []string{ids[0], ids[0], ids[0]}, ids)
+
+	result, err := s.GetResult(ctx, &api.GetResultRequest{Id: ids[0]})
+	if err != nil {
+		t.Fatalf("Unable to get result: %s", err)
+	}
+	assert.Equal(t, "PASS", result.Outcome, "expected %s got %s", "PASS", result.Outcome)
+
+	var history []string
+	gormDB.Table("result_history").Where("result_id = ?", ids[0]).Order("id").Pluck("outcome", &history)
+	expected := []string{"ERROR", "FAIL"}
+	assert.Equal(t, expected, history, "expected %v got %v", expected, history)
+
+	// Results without an assessment are still appended
+	for i := 0; i < 2; i++ {
+		_, err := s.SetResult(ctx, &api.ResultRequest{Subject: clusterName, Control: "AC-2", Rule: "rule-1", Outcome: "PASS"})
+		if err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+	var results []Result
+	count := gormDB.Find(&results).RowsAffected
+	assert.Equal(t, int64(3), count, "expected %d got %d", 3, count)
+}
+
+func TestListResultsFiltersAndPages(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+
+	assessmentID, err := insertAssessment()
+	if err != nil {
+		t.Fatalf("Unable to create necessary assessment: %s", err)
+	}
+
+	numResults := 5
+	for i := 0; i < numResults; i++ {
+		r := &api.ResultRequest{
+			Subject: clusterName, Control: "AC-2", Rule: getUUIDString(),
+			AssessmentId: assessmentID, Outcome: "PASS", Severity: "high",
+		}
+		if _, err := s.SetResult(context.Background(), r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+	r := &api.ResultRequest{
+		Subject: clusterName, Control: "AC-6", Rule: getUUIDString(),
+		AssessmentId: assessmentID, Outcome: "FAIL", Severity: "low",
+		Extra: map[string]string{"scanner": "compliance-operator"},
+	}
+	failed, err := s.SetResult(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+
+	// Filter on outcome
+	request := &api.ListResultsRequest{Filter: &api.ResultFilter{Outcome: "FAIL"}}
+	response, err := s.ListResults(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unable to list results: %s", err)
+	}
+	if assert.Len(t, response.Results, 1) {
+		a := response.Results[0]
+		assert.Equal(t, failed.Id, a.Id, "expected %s got %s", failed.Id, a.Id)
+		assert.Equal(t, "AC-6", a.Control, "expected %s got %s", "AC-6", a.Control)
+		assert.Equal(t, "low", a.Severity, "expected %s got %s", "low", a.Severity)
+		assert.Equal(t, clusterName, a.Subject, "expected %s got %s", clusterName, a.Subject)
+		assert.Equal(t, r.Extra, a.Extra)
+		assert.NotNil(t, a.CreatedAt)
+	}
+	assert.Empty(t, response.NextPageToken)
+
+	// Page through the results for AC-2 and make sure we see every result
+	// exactly once.
+	seen := map[string]bool{}
+	request = &api.ListResultsRequest{
+		Filter:   &api.ResultFilter{Control: "AC-2", AssessmentId: assessmentID, Severity: "high"},
+		PageSize: 2,
+	}
+	for pages := 0; ; pages++ {
+		if pages > numResults {
+			t.Fatalf("Paging didn't terminate")
+		}
+		response, err = s.ListResults(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unable to list results: %s", err)
+		}
+		assert.LessOrEqual(t, len(response.Results), 2)
+		for _, a := range response.Results {
+			assert.False(t, seen[a.Id], "Result returned more than once: %s", a.Id)
+			seen[a.Id] = true
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		request.PageToken = response.NextPageToken
+	}
+	assert.Len(t, seen, numResults)
+
+	request = &api.ListResultsRequest{PageToken: "not-a-token"}
+	_, err = s.ListResults(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetResult(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+
+	r := &api.ResultRequest{Subject: clusterName, Control: "AC-2", Rule: getUUIDString(), Outcome: "PASS"}
+	response, err := s.SetResult(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+
+	a, err := s.GetResult(context.Background(), &api.GetResultRequest{Id: response.Id})
+	if err != nil {
+		t.Fatalf("Unable to get result: %s", err)
+	}
+	assert.Equal(t, response.Id, a.Id, "expected %s got %s", response.Id, a.Id)
+	assert.Equal(t, r.Rule, a.Rule, "expected %s got %s", r.Rule, a.Rule)
+	assert.Equal(t, r.Outcome, a.Outcome, "expected %s got %s", r.Outcome, a.Outcome)
+	assert.Empty(t, a.AssessmentId)
+
+	_, err = s.GetResult(context.Background(), &api.GetResultRequest{Id: getUUIDString()})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, status.Code(err))
+}
+
+func TestSubjectHierarchy(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	cluster, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: clusterName, Type: "cluster"})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	node, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "node-1", Type: "node", ParentId: cluster.Id})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	namespace, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{
+		Name: "openshift-compliance", Type: "namespace", ParentId: node.Id,
+	})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+
+	_, err = s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "orphan", ParentId: getUUIDString()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+
+	a, err := s.GetSubject(ctx, &api.GetSubjectRequest{Id: node.Id})
+	if err != nil {
+		t.Fatalf("Unable to get subject: %s", err)
+	}
+	assert.Equal(t, "node-1", a.Name, "expected %s got %s", "node-1", a.Name)
+	assert.Equal(t, cluster.Id, a.ParentId, "expected %s got %s", cluster.Id, a.ParentId)
+
+	children, err := s.ListSubjects(ctx, &api.ListSubjectsRequest{ParentId: cluster.Id})
+	if err != nil {
+		t.Fatalf("Unable to list subjects: %s", err)
+	}
+	if assert.Len(t, children.Subjects, 1) {
+		assert.Equal(t, node.Id, children.Subjects[0].Id)
+	}
+
+	descendants, err := s.ListSubjectDescendants(ctx, &api.ListSubjectDescendantsRequest{Id: cluster.Id})
+	if err != nil {
+		t.Fatalf("Unable to list descendants: %s", err)
+	}
+	if assert.Len(t, descendants.Descendants, 2) {
+		assert.Equal(t, node.Id, descendants.Descendants[0].Subject.Id)
+		assert.Equal(t, int32(1), descendants.Descendants[0].Depth)
+		assert.Equal(t, namespace.Id, descendants.Descendants[1].Subject.Id)
+		assert.Equal(t, int32(2), descendants.Descendants[1].Depth)
+	}
+	descendants, err = s.ListSubjectDescendants(ctx, &api.ListSubjectDescendantsRequest{Id: cluster.Id, MaxDepth: 1})
+	if err != nil {
+		t.Fatalf("Unable to list descendants: %s", err)
+	}
+	assert.Len(t, descendants.Descendants, 1)
+
+	// A subject can't be moved below one of its descendants
+	_, err = s.UpdateSubject(ctx, &api.UpdateSubjectRequest{
+		Id: cluster.Id, Name: clusterName, Type: "cluster", ParentId: namespace.Id,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+
+	updated, err := s.UpdateSubject(ctx, &api.UpdateSubjectRequest{
+		Id: namespace.Id, Name: "openshift-compliance", Type: "namespace", ParentId: cluster.Id,
+	})
+	if err != nil {
+		t.Fatalf("Unable to update subject: %s", err)
+	}
+	assert.Equal(t, cluster.Id, updated.ParentId, "expected %s got %s", cluster.Id, updated.ParentId)
+}
+
+func TestSubjectDepthLimit(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	// Subjects can be nested 64 levels below a top-level subject.
+	maxDepth := 64
+	chain := make([]*api.Subject, 0, maxDepth+1)
+	parentID := ""
+	for i := 0; i <= maxDepth; i++ {
+		subject, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: fmt.Sprintf("level-%d", i), ParentId: parentID})
+		if err != nil {
+			t.Fatalf("Unable to create subject at depth %d: %s", i, err)
+		}
+		chain = append(chain, subject)
+		parentID = subject.Id
+	}
+	_, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "too-deep", ParentId: parentID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+
+	// Moving a subject moves its descendants too.
+	top, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "top"})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	_, err = s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "child", ParentId: top.Id})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	_, err = s.UpdateSubject(ctx, &api.UpdateSubjectRequest{Id: top.Id, Name: "top", ParentId: chain[maxDepth-1].Id})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+	_, err = s.UpdateSubject(ctx, &api.UpdateSubjectRequest{Id: top.Id, Name: "top", ParentId: chain[maxDepth-2].Id})
+	assert.Nil(t, err, "Unable to move subject: %s", err)
+
+	// Every level of the tree can be deleted at once.
+	_, err = s.DeleteSubject(ctx, &api.DeleteSubjectRequest{Id: chain[0].Id, Cascade: true})
+	assert.Nil(t, err, "Unable to delete subject: %s", err)
+}
+
+func TestDeleteSubjectWithCascade(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	s := api.NewServer(gormDB)
+	ctx := context.Background()
+
+	cluster, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: clusterName, Type: "cluster"})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	node, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "node-1", Type: "node", ParentId: cluster.Id})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	r := &api.ResultRequest{Subject: "node-1", Control: "AC-2", Rule: getUUIDString(), Outcome: "PASS"}
+	if _, err := s.SetResult(ctx, r); err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+
+	// The parent subject has a child so it can't be deleted without
+	// cascading the delete.
+	_, err = s.DeleteSubject(ctx, &api.DeleteSubjectRequest{Id: cluster.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err),
+		"expected %s got %s", codes.FailedPrecondition, status.Code(err))
+	// The child has a result so it can't be deleted either.
+	_, err = s.DeleteSubject(ctx, &api.DeleteSubjectRequest{Id: node.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err),
+		"expected %s got %s", codes.FailedPrecondition, status.Code(err))
+
+	response, err := s.DeleteSubject(ctx, &api.DeleteSubjectRequest{Id: cluster.Id, Cascade: true})
+	if err != nil {
+		t.Fatalf("Unable to delete subject: %s", err)
+	}
+	assert.Equal(t, int64(2), response.Deleted, "expected %d got %d", 2, response.Deleted)
+
+	var subjects []Subject
+	result := gormDB.Find(&subjects)
+	assert.Equal(t, int64(0), result.RowsAffected, "expected %d got %d", 0, result.RowsAffected)
+	var results []Result
+	result = gormDB.Find(&results)
+	assert.Equal(t, int64(0), result.RowsAffected, "expected %d got %d", 0, result.RowsAffected)
+
+	_, err = s.GetSubject(ctx, &api.GetSubjectRequest{Id: cluster.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, status.Code(err))
+}
+
+func TestAssessmentLifecycle(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	a, err := s.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "nightly cis scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	assert.Equal(t, api.AssessmentState_ASSESSMENT_STATE_IN_PROGRESS, a.State)
+	assert.NotNil(t, a.StartedAt)
+	assert.Nil(t, a.FinishedAt)
+
+	r := &api.ResultRequest{
+		Subject: clusterName, Control: "AC-2", Rule: getUUIDString(), Outcome: "PASS", AssessmentId: a.Id,
+	}
+	if _, err := s.SetResult(ctx, r); err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+
+	// Results submitted without an assessment can be attached later
+	r.AssessmentId = ""
+	detached, err := s.SetResult(ctx, r)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	attached, err := s.AttachResults(ctx, &api.AttachResultsRequest{AssessmentId: a.Id, ResultIds: []string{detached.Id}})
+	if err != nil {
+		t.Fatalf("Unable to attach results: %s", err)
+	}
+	assert.Equal(t, int64(1), attached.Attached, "expected %d got %d", 1, attached.Attached)
+
+	inProgress, err := s.ListAssessments(ctx, &api.ListAssessmentsRequest{
+		State: api.AssessmentState_ASSESSMENT_STATE_IN_PROGRESS,
+	})
+	if err != nil {
+		t.Fatalf("Unable to list assessments: %s", err)
+	}
+	assert.Len(t, inProgress.Assessments, 1)
+
+	closed, err := s.CloseAssessment(ctx, &api.CloseAssessmentRequest{Id: a.Id})
+	if err != nil {
+		t.Fatalf("Unable to close assessment: %s", err)
+	}
+	assert.Equal(t, api.AssessmentState_ASSESSMENT_STATE_COMPLETED, closed.State)
+	assert.NotNil(t, closed.FinishedAt)
+
+	// Closed assessments don't accept new results
+	r.AssessmentId = a.Id
+	_, err = s.SetResult(ctx, r)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err),
+		"expected %s got %s", codes.FailedPrecondition, status.Code(err))
+	_, err = s.CloseAssessment(ctx, &api.CloseAssessmentRequest{Id: a.Id, Abandoned: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err),
+		"expected %s got %s", codes.FailedPrecondition, status.Code(err))
+
+	results, err := s.ListResults(ctx, &api.ListResultsRequest{Filter: &api.ResultFilter{AssessmentId: a.Id}})
+	if err != nil {
+		t.Fatalf("Unable to list results: %s", err)
+	}
+	assert.Len(t, results.Results, 2)
+
+	a, err = s.GetAssessment(ctx, &api.GetAssessmentRequest{Id: a.Id})
+	if err != nil {
+		t.Fatalf("Unable to get assessment: %s", err)
+	}
+	assert.Equal(t, api.AssessmentState_ASSESSMENT_STATE_COMPLETED, a.State)
+}
+
+func TestImportCatalogIsIdempotent(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	s := api.NewServer(gormDB)
+	ctx := context.Background()
+
+	content, err := os.ReadFile(testCatalogPath)
+	if err != nil {
+		t.Fatalf("Unable to read catalog: %s", err)
+	}
+	response, err := s.ImportCatalog(ctx, &api.ImportCatalogRequest{Content: content})
+	if err != nil {
+		t.Fatalf("Unable to import catalog: %s", err)
+	}
+	assert.True(t, response.Created)
+	assert.Equal(t, "5.1.1", response.Version, "expected %s got %s", "5.1.1", response.Version)
+	assert.Equal(t, int64(4), response.Controls, "expected %d got %d", 4, response.Controls)
+
+	control := Control{}
+	gormDB.First(&control, "name = ?", "AC-2(1)")
+	assert.NotEmpty(t, control.ID, "Control enhancements should be imported")
+
+	// Importing the same catalog version shouldn't create duplicates
+	again, err := s.ImportCatalog(ctx, &api.ImportCatalogRequest{Content: content})
+	if err != nil {
+		t.Fatalf("Unable to import catalog: %s", err)
+	}
+	assert.False(t, again.Created)
+	assert.Equal(t, response.CatalogId, again.CatalogId, "expected %s got %s", response.CatalogId, again.CatalogId)
+
+	var catalogs []Catalog
+	result := gormDB.Find(&catalogs)
+	assert.Equal(t, int64(1), result.RowsAffected, "expected %d got %d", 1, result.RowsAffected)
+	var controls []Control
+	result = gormDB.Find(&controls)
+	assert.Equal(t, int64(4), result.RowsAffected, "expected %d got %d", 4, result.RowsAffected)
+
+	_, err = s.ImportCatalog(ctx, &api.ImportCatalogRequest{Content: []byte("{}")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+}
+
+func TestImportProfileResolvesControls(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	s := api.NewServer(gormDB)
+	ctx := context.Background()
+
+	content, err := os.ReadFile(testCatalogPath)
+	if err != nil {
+		t.Fatalf("Unable to read catalog: %s", err)
+	}
+	catalog, err := s.ImportCatalog(ctx, &api.ImportCatalogRequest{Content: content})
+	if err != nil {
+		t.Fatalf("Unable to import catalog: %s", err)
+	}
+	content, err = os.ReadFile(testProfilePath)
+	if err != nil {
+		t.Fatalf("Unable to read profile: %s", err)
+	}
+
+	// Imports can't be resolved without knowing which catalog they use
+	_, err = s.ImportProfile(ctx, &api.ImportProfileRequest{Content: content})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+
+	request := &api.ImportProfileRequest{
+		Content:    content,
+		CatalogIds: map[string]string{testProfileCatalogHref: catalog.CatalogId},
+	}
+	response, err := s.ImportProfile(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to import profile: %s", err)
+	}
+	assert.True(t, response.Created)
+	assert.Equal(t, int64(2), response.Controls, "expected %d got %d", 2, response.Controls)
+	assert.Equal(t, []string{catalog.CatalogId}, response.CatalogIds)
+
+	var controls []Control
+	gormDB.Where("profile_id = ?", response.ProfileId).Order("name").Find(&controls)
+	names := []string{}
+	for _, c := range controls {
+		names = append(names, c.Name)
+	}
+	expected := []string{"AC-2", "AC-2(1)"}
+	assert.Equal(t, expected, names, "expected %s got %s", expected, names)
+
+	// The catalog controls are left alone
+	var count int64
+	gormDB.Model(&Control{}).Where("profile_id IS NULL").Count(&count)
+	assert.Equal(t, int64(4), count, "expected %d got %d", 4, count)
+
+	// Importing the same profile version shouldn't create duplicates
+	again, err := s.ImportProfile(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to import profile: %s", err)
+	}
+	assert.False(t, again.Created)
+	assert.Equal(t, response.ProfileId, again.ProfileId, "expected %s got %s", response.ProfileId, again.ProfileId)
+	gormDB.Model(&Control{}).Where("profile_id = ?", response.ProfileId).Count(&count)
+	assert.Equal(t, int64(2), count, "expected %d got %d", 2, count)
+
+	profile := Profile{}
+	gormDB.First(&profile, "id = ?", response.ProfileId)
+	assert.Equal(t, catalog.CatalogId, profile.CatalogID, "expected %s got %s", catalog.CatalogId, profile.CatalogID)
+	var source string
+	gormDB.Table("profiles").Select("content").Where("id = ?", response.ProfileId).Scan(&source)
+	assert.Equal(t, string(content), source, "The unresolved profile should be stored")
+
+	// Results reference the catalog control rather than the profile's copy
+	// of it, or a control with the same name that isn't in a catalog
+	err = gormDB.Exec("INSERT INTO controls (id, name) VALUES (?, ?)", getUUIDString(), "AC-2").Error
+	if err != nil {
+		t.Fatalf("Unable to create control: %s", err)
+	}
+	r := &api.ResultRequest{Subject: clusterName, Control: "AC-2", Rule: "rule-1", Outcome: "PASS"}
+	set, err := s.SetResult(ctx, r)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	control := Control{}
+	gormDB.First(&control, "name = ? AND catalog_id = ? AND profile_id IS NULL", "AC-2", catalog.CatalogId)
+	result := Result{}
+	gormDB.First(&result, "id = ?", set.Id)
+	assert.Equal(t, control.ID, result.ControlID, "expected %s got %s", control.ID, result.ControlID)
+	gormDB.Model(&Control{}).Where("name = ?", "AC-2").Count(&count)
+	assert.Equal(t, int64(3), count, "expected %d got %d", 3, count)
+
+	// Controls the profile stops selecting are removed, and whatever
+	// referenced them moves to the catalog control
+	enhancement := Control{}
+	gormDB.First(&enhancement, "name = ? AND profile_id = ?", "AC-2(1)", response.ProfileId)
+	gormDB.Model(&Result{}).Where("id = ?", set.Id).Update("control_id", enhancement.ID)
+	request.Content = []byte(strings.Replace(string(content), `"with-child-controls": "yes"`,
+		`"with-child-controls": "no"`, 1))
+	again, err = s.ImportProfile(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to import profile: %s", err)
+	}
+	assert.Equal(t, int64(1), again.Controls, "expected %d got %d", 1, again.Controls)
+	gormDB.Model(&Control{}).Where("profile_id = ?", response.ProfileId).Count(&count)
+	assert.Equal(t, int64(1), count, "expected %d got %d", 1, count)
+	gormDB.Model(&Control{}).Where("id = ?", enhancement.ID).Count(&count)
+	assert.Equal(t, int64(0), count, "expected %d got %d", 0, count)
+	control = Control{}
+	gormDB.First(&control, "name = ? AND catalog_id = ? AND profile_id IS NULL", "AC-2(1)", catalog.CatalogId)
+	result = Result{}
+	gormDB.First(&result, "id = ?", set.Id)
+	assert.Equal(t, control.ID, result.ControlID, "expected %s got %s", control.ID, result.ControlID)
+}
+
+func TestQueryControlPosture(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	cluster, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: clusterName, Type: "cluster"})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	other, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "other.example.com", Type: "cluster"})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	for _, r := range []*api.CreateSubjectRequest{
+		{Name: "node-1", Type: "node", ParentId: cluster.Id},
+		{Name: "node-2", Type: "node", ParentId: cluster.Id},
+		{Name: "node-3", Type: "node", ParentId: other.Id},
+	} {
+		if _, err := s.CreateSubject(ctx, r); err != nil {
+			t.Fatalf("Unable to create subject: %s", err)
+		}
+	}
+	abandoned, err := s.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "failed scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+
+	requests := []*api.ResultRequest{
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-1", Outcome: "FAIL"},
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-1", Outcome: "PASS"},
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-2", Outcome: "FAIL"},
+		{Subject: "node-2", Control: "AC-2", Rule: "rule-1", Outcome: "PASS"},
+		{Subject: "node-2", Control: "AC-2", Rule: "rule-1", Outcome: "FAIL", AssessmentId: abandoned.Id},
+		{Subject: "node-2", Control: "AU-2", Rule: "rule-3", Outcome: "FAIL"},
+		{Subject: "node-3", Control: "AC-2", Rule: "rule-1", Outcome: "FAIL"},
+	}
+	for _, r := range requests {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+	if _, err := s.CloseAssessment(ctx, &api.CloseAssessmentRequest{Id: abandoned.Id, Abandoned: true}); err != nil {
+		t.Fatalf("Unable to close assessment: %s", err)
+	}
+
+	response, err := s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{
+		Control: "AC-2", RootSubjectId: cluster.Id,
+	})
+	if err != nil {
+		t.Fatalf("Unable to query control posture: %s", err)
+	}
+	outcomes := map[string][]string{}
+	for _, p := range response.Subjects {
+		for _, r := range p.Results {
+			outcomes[p.Subject.Name] = append(outcomes[p.Subject.Name], r.Rule+"="+r.Outcome)
+		}
+	}
+	expected := map[string][]string{
+		"node-1": {"rule-1=PASS", "rule-2=FAIL"},
+		"node-2": {"rule-1=PASS"},
+	}
+	assert.Equal(t, expected, outcomes, "expected %s got %s", expected, outcomes)
+
+	// Only subjects with a failing rule
+	response, err = s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{
+		Control: "AC-2", RootSubjectId: cluster.Id, Outcome: "FAIL",
+	})
+	if err != nil {
+		t.Fatalf("Unable to query control posture: %s", err)
+	}
+	if assert.Len(t, response.Subjects, 1) {
+		assert.Equal(t, "node-1", response.Subjects[0].Subject.Name)
+		assert.Len(t, response.Subjects[0].Results, 2)
+	}
+
+	// Page through the whole fleet
+	var names []string
+	request := &api.QueryControlPostureRequest{Control: "AC-2", PageSize: 2}
+	for {
+		page, err := s.QueryControlPosture(ctx, request)
+		if err != nil {
+			t.Fatalf("Unable to query control posture: %s", err)
+		}
+		for _, p := range page.Subjects {
+			names = append(names, p.Subject.Name)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		request.PageToken = page.NextPageToken
+	}
+	assert.ElementsMatch(t, []string{"node-1", "node-2", "node-3"}, names)
+
+	_, err = s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{Control: "AC-2", RootSubjectId: getUUIDString()})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, status.Code(err))
+	_, err = s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+}
+
+func TestWatchResultsStreamsAndResumes(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	client := getClientHelper(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	request := &api.WatchResultsRequest{Filter: &api.ResultFilter{Outcome: "FAIL"}}
+	watch, err := client.WatchResults(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to watch results: %s", err)
+	}
+	// The first message marks the start of the live feed
+	header, err := watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	assert.Nil(t, header.Result)
+	assert.NotEmpty(t, header.ResumeToken)
+
+	pass := &api.ResultRequest{Subject: clusterName, Control: "AC-2", Rule: getUUIDString(), Outcome: "PASS"}
+	fail := &api.ResultRequest{Subject: clusterName, Control: "AC-2", Rule: getUUIDString(), Outcome: "FAIL"}
+	if _, err := client.SetResult(ctx, pass); err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	failed, err := client.SetResult(ctx, fail)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	event, err := watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	if assert.NotNil(t, event.Result) {
+		assert.Equal(t, failed.Id, event.Result.Id, "expected %s got %s", failed.Id, event.Result.Id)
+		assert.Equal(t, fail.Rule, event.Result.Rule, "expected %s got %s", fail.Rule, event.Result.Rule)
+	}
+
+	// Results persisted while disconnected are replayed when resuming
+	cancel()
+	ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	fail.Rule = getUUIDString()
+	missed, err := client.SetResult(ctx, fail)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	request.ResumeToken = event.ResumeToken
+	watch, err = client.WatchResults(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to watch results: %s", err)
+	}
+	replayed, err := watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	if assert.NotNil(t, replayed.Result) {
+		assert.Equal(t, missed.Id, replayed.Result.Id, "expected %s got %s", missed.Id, replayed.Result.Id)
+	}
+	header, err = watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	assert.Nil(t, header.Result)
+
+	watch, err = client.WatchResults(ctx, &api.WatchResultsRequest{ResumeToken: "invalid"})
+	if err != nil {
+		t.Fatalf("Unable to watch results: %s", err)
+	}
+	_, err = watch.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+}
+
+func TestWatchResultsResendsReplayedUpdates(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	client := getClientHelper(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	request := &api.WatchResultsRequest{}
+	watch, err := client.WatchResults(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to watch results: %s", err)
+	}
+	header, err := watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	cancel()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	assessment, err := client.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "nightly scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	r := &api.ResultRequest{
+		Subject:      clusterName,
+		Control:      "AC-2",
+		Rule:         getUUIDString(),
+		Outcome:      "FAIL",
+		AssessmentId: assessment.Id,
+	}
+	missed, err := client.SetResult(ctx, r)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	request.ResumeToken = header.ResumeToken
+	watch, err = client.WatchResults(ctx, request)
+	if err != nil {
+		t.Fatalf("Unable to watch results: %s", err)
+	}
+	replayed, err := watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	if assert.NotNil(t, replayed.Result) {
+		assert.Equal(t, missed.Id, replayed.Result.Id, "expected %s got %s", missed.Id, replayed.Result.Id)
+	}
+	header, err = watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	assert.Nil(t, header.Result)
+
+	// Replacing a replayed result during the same assessment sends it again
+	r.Outcome = "PASS"
+	updated, err := client.SetResult(ctx, r)
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	assert.Equal(t, missed.Id, updated.Id, "expected %s got %s", missed.Id, updated.Id)
+	event, err := watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	if assert.NotNil(t, event.Result) {
+		assert.Equal(t, missed.Id, event.Result.Id, "expected %s got %s", missed.Id, event.Result.Id)
+		assert.Equal(t, "PASS", event.Result.Outcome, "expected %s got %s", "PASS", event.Result.Outcome)
+	}
+}
+
+func TestWatchResultsReplaysLateCommits(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	client := getClientHelper(t)
+	gormDB := getGormHelper()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	watch, err := client.WatchResults(ctx, &api.WatchResultsRequest{})
+	if err != nil {
+		t.Fatalf("Unable to watch results: %s", err)
+	}
+	if _, err := watch.Recv(); err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+
+	// The first result gets its sequence number first, but commits last
+	subjectID, err := insertSubject()
+	if err != nil {
+		t.Fatalf("Unable to create necessary subject: %s", err)
+	}
+	lateID := getUUIDString()
+	tx := gormDB.Begin()
+	defer tx.Rollback()
+	err = tx.Exec("INSERT INTO results (id, name, outcome, subject_id) VALUES (?, ?, ?, ?)",
+		lateID, getUUIDString(), "FAIL", subjectID).Error
+	if err != nil {
+		t.Fatalf("Unable to create result: %s", err)
+	}
+	r := &api.ResultRequest{Subject: clusterName, Control: "AC-2", Rule: getUUIDString(), Outcome: "FAIL"}
+	if _, err := client.SetResult(ctx, r); err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	event, err := watch.Recv()
+	if err != nil {
+		t.Fatalf("Unable to receive from watch: %s", err)
+	}
+	cancel()
+	if err := tx.Commit().Error; err != nil {
+		t.Fatalf("Unable to commit result: %s", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	watch, err = client.WatchResults(ctx, &api.WatchResultsRequest{ResumeToken: event.ResumeToken})
+	if err != nil {
+		t.Fatalf("Unable to watch results: %s", err)
+	}
+	replayed := []string{}
+	for {
+		response, err := watch.Recv()
+		if err != nil {
+			t.Fatalf("Unable to receive from watch: %s", err)
+		}
+		if response.Result == nil {
+			break
+		}
+		replayed = append(replayed, response.Result.Id)
+	}
+	assert.Contains(t, replayed, lateID, "Results committed after the resume token should be replayed")
+}
+
+func TestGetComplianceSummary(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	cluster, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: clusterName, Type: "cluster"})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	for _, name := range []string{"node-1", "node-2"} {
+		_, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: name, Type: "node", ParentId: cluster.Id})
+		if err != nil {
+			t.Fatalf("Unable to create subject: %s", err)
+		}
+	}
+	requests := []*api.ResultRequest{
+		{Subject: "node-1", Control: "AC-2", Severity: "high", Rule: "rule-1", Outcome: "PASS"},
+		{Subject: "node-1", Control: "AC-2", Severity: "high", Rule: "rule-2", Outcome: "FAIL"},
+		{Subject: "node-2", Control: "AC-2", Severity: "high", Rule: "rule-1", Outcome: "PASS"},
+		{Subject: "node-2", Control: "AC-2", Severity: "high", Rule: "rule-2", Outcome: "ERROR"},
+		{Subject: clusterName, Control: "CM-6", Severity: "medium", Rule: "rule-3", Outcome: "PASS"},
+		// Fixes the failure reported earlier for node-1
+		{Subject: "node-1", Control: "AC-2", Severity: "high", Rule: "rule-2", Outcome: "PASS"},
+	}
+	for _, r := range requests {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+
+	summary, err := s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{})
+	if err != nil {
+		t.Fatalf("Unable to get compliance summary: %s", err)
+	}
+	assert.Equal(t, int64(6), summary.Overall.Total, "expected %d got %d", 6, summary.Overall.Total)
+	assert.Equal(t, map[string]int64{"PASS": 4, "FAIL": 1, "ERROR": 1}, summary.Overall.Outcomes)
+	assert.Empty(t, summary.Groups)
+
+	// Each subject includes the results of its descendants
+	summary, err = s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{
+		GroupBy: api.SummaryGrouping_SUMMARY_GROUPING_SUBJECT, Latest: true,
+	})
+	if err != nil {
+		t.Fatalf("Unable to get compliance summary: %s", err)
+	}
+	assert.Equal(t, int64(5), summary.Overall.Total, "expected %d got %d", 5, summary.Overall.Total)
+	assert.InDelta(t, 80.0, summary.Overall.PassPercentage, 0.001)
+	totals := map[string]int64{}
+	for _, g := range summary.Groups {
+		totals[g.Name] = g.Total
+	}
+	expected := map[string]int64{clusterName: 5, "node-1": 2, "node-2": 2}
+	assert.Equal(t, expected, totals, "expected %v got %v", expected, totals)
+
+	summary, err = s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{
+		GroupBy: api.SummaryGrouping_SUMMARY_GROUPING_SUBJECT_TYPE, Latest: true,
+	})
+	if err != nil {
+		t.Fatalf("Unable to get compliance summary: %s", err)
+	}
+	totals = map[string]int64{}
+	for _, g := range summary.Groups {
+		totals[g.Key] = g.Total
+	}
+	expected = map[string]int64{"cluster": 5, "node": 4}
+	assert.Equal(t, expected, totals, "expected %v got %v", expected, totals)
+
+	summary, err = s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{
+		GroupBy: api.SummaryGrouping_SUMMARY_GROUPING_SEVERITY, Latest: true,
+	})
+	if err != nil {
+		t.Fatalf("Unable to get compliance summary: %s", err)
+	}
+	if assert.Len(t, summary.Groups, 2) {
+		high := summary.Groups[0]
+		assert.Equal(t, "high", high.Key, "expected %s got %s", "high", high.Key)
+		assert.Equal(t, int64(3), high.Passed, "expected %d got %d", 3, high.Passed)
+		assert.Equal(t, int64(1), high.Errored, "expected %d got %d", 1, high.Errored)
+		assert.InDelta(t, 75.0, high.PassPercentage, 0.001)
+	}
+
+	_, err = s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{RootSubjectId: getUUIDString()})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, status.Code(err))
+}
+
+func TestDiffAssessments(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	base, err := s.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "monday scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	target, err := s.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "tuesday scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	outcomes := []struct {
+		assessmentID string
+		rule         string
+		outcome      string
+	}{
+		{base.Id, "rule-1", "PASS"},
+		{base.Id, "rule-2", "FAIL"},
+		{base.Id, "rule-3", "PASS"},
+		{base.Id, "rule-4", "PASS"},
+		{base.Id, "rule-6", "FAIL"},
+		{target.Id, "rule-1", "FAIL"},
+		{target.Id, "rule-2", "PASS"},
+		{target.Id, "rule-3", "PASS"},
+		{target.Id, "rule-5", "FAIL"},
+		{target.Id, "rule-6", "NOT-APPLICABLE"},
+	}
+	for _, o := range outcomes {
+		r := &api.ResultRequest{
+			Subject: clusterName, Control: "AC-2", Rule: o.rule, Outcome: o.outcome, AssessmentId: o.assessmentID,
+		}
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+
+	diff, err := s.DiffAssessments(ctx, &api.DiffAssessmentsRequest{
+		BaseAssessmentId: base.Id, TargetAssessmentId: target.Id,
+	})
+	if err != nil {
+		t.Fatalf("Unable to diff assessments: %s", err)
+	}
+	rules := func(diffs []*api.ResultDiff) []string {
+		r := []string{}
+		for _, d := range diffs {
+			r = append(r, d.Rule)
+		}
+		return r
+	}
+	assert.Equal(t, []string{"rule-1"}, rules(diff.Regressed))
+	assert.Equal(t, []string{"rule-2"}, rules(diff.Fixed))
+	assert.Equal(t, []string{"rule-6"}, rules(diff.Changed))
+	assert.Equal(t, []string{"rule-5"}, rules(diff.Added))
+	assert.Equal(t, []string{"rule-4"}, rules(diff.Removed))
+	assert.Equal(t, int64(1), diff.Unchanged, "expected %d got %d", 1, diff.Unchanged)
+	if assert.Len(t, diff.Regressed, 1) {
+		r := diff.Regressed[0]
+		assert.Equal(t, clusterName, r.Subject, "expected %s got %s", clusterName, r.Subject)
+		assert.Equal(t, "PASS", r.Base.Outcome, "expected %s got %s", "PASS", r.Base.Outcome)
+		assert.Equal(t, "FAIL", r.Target.Outcome, "expected %s got %s", "FAIL", r.Target.Outcome)
+	}
+	if assert.Len(t, diff.Added, 1) {
+		assert.Nil(t, diff.Added[0].Base)
+	}
+
+	_, err = s.DiffAssessments(ctx, &api.DiffAssessmentsRequest{
+		BaseAssessmentId: base.Id, TargetAssessmentId: getUUIDString(),
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, status.Code(err))
+}
+
+func TestExportAssessmentResults(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	_, err := s.SetRule(ctx, &api.SetRuleRequest{Name: "rule-1", Controls: []string{"AC-6"}})
+	if err != nil {
+		t.Fatalf("Unable to set rule: %s", err)
+	}
+	a, err := s.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "nightly scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	requests := []*api.ResultRequest{
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-1", Outcome: "PASS", AssessmentId: a.Id},
+		{Subject: "node-2", Control: "AU-2", Rule: "rule-2", Outcome: "FAIL", AssessmentId: a.Id},
+	}
+	resultIDs := map[string]bool{}
+	subjectIDs := map[string]bool{}
+	for _, r := range requests {
+		response, err := s.SetResult(ctx, r)
+		if err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+		result, err := s.GetResult(ctx, &api.GetResultRequest{Id: response.Id})
+		if err != nil {
+			t.Fatalf("Unable to get result: %s", err)
+		}
+		resultIDs[result.Id] = true
+		subjectIDs[result.SubjectId] = true
+	}
+	if _, err := s.CloseAssessment(ctx, &api.CloseAssessmentRequest{Id: a.Id}); err != nil {
+		t.Fatalf("Unable to close assessment: %s", err)
+	}
+
+	response, err := s.ExportAssessmentResults(ctx, &api.ExportAssessmentResultsRequest{
+		Id: a.Id, AssessmentPlanHref: "https://example.com/plan.json",
+	})
+	if err != nil {
+		t.Fatalf("Unable to export assessment results: %s", err)
+	}
+	doc := oscal.AssessmentResultsDocument{}
+	if err := json.Unmarshal(response.Content, &doc); err != nil {
+		t.Fatalf("Unable to decode assessment results: %s", err)
+	}
+	ar := doc.AssessmentResults
+	assert.Equal(t, "https://example.com/plan.json", ar.ImportAP.Href)
+	assert.Nil(t, ar.BackMatter)
+	if !assert.Len(t, ar.Results, 1) {
+		return
+	}
+	r := ar.Results[0]
+	assert.Equal(t, a.Id, r.UUID, "expected %s got %s", a.Id, r.UUID)
+	assert.NotNil(t, r.End)
+
+	// Resources keep their IDs
+	got := map[string]bool{}
+	for _, o := range r.Observations {
+		got[o.UUID] = true
+	}
+	assert.Equal(t, resultIDs, got, "expected %v got %v", resultIDs, got)
+	got = map[string]bool{}
+	for _, item := range r.LocalDefinitions.InventoryItems {
+		got[item.UUID] = true
+	}
+	assert.Equal(t, subjectIDs, got, "expected %v got %v", subjectIDs, got)
+
+	// rule-1 is mapped to AC-6, so its result is evidence for AC-6 as well
+	states := map[string]string{}
+	for _, f := range r.Findings {
+		states[f.Target.TargetID] = f.Target.Status.State
+	}
+	expected := map[string]string{
+		"ac-2": oscal.StateSatisfied,
+		"ac-6": oscal.StateSatisfied,
+		"au-2": oscal.StateNotSatisfied,
+	}
+	assert.Equal(t, expected, states, "expected %v got %v", expected, states)
+
+	_, err = s.ExportAssessmentResults(ctx, &api.ExportAssessmentResultsRequest{Id: getUUIDString()})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, status.Code(err))
+}
+
+func TestGetComplianceTrend(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	requests := []*api.ResultRequest{
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-1", Outcome: "PASS"},
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-2", Outcome: "FAIL"},
+		{Subject: "node-2", Control: "CM-6", Rule: "rule-3", Outcome: "PASS"},
+		{Subject: "node-2", Control: "CM-6", Rule: "rule-4", Outcome: "PASS"},
+	}
+	for _, r := range requests {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+
+	trend, err := s.GetComplianceTrend(ctx, &api.GetComplianceTrendRequest{})
+	if err != nil {
+		t.Fatalf("Unable to get compliance trend: %s", err)
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if assert.Len(t, trend.Points, 1) {
+		p := trend.Points[0]
+		assert.Equal(t, today, p.Start.AsTime(), "expected %s got %s", today, p.Start.AsTime())
+		assert.Equal(t, int64(4), p.Summary.Total, "expected %d got %d", 4, p.Summary.Total)
+		assert.InDelta(t, 75.0, p.Summary.PassPercentage, 0.001)
+	}
+
+	// Results reported after the rollups were calculated are included,
+	// unless their assessment was abandoned.
+	a, err := s.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "abandoned scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	requests = []*api.ResultRequest{
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-2", Outcome: "PASS"},
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-1", Outcome: "FAIL", AssessmentId: a.Id},
+	}
+	for _, r := range requests {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+	if _, err := s.CloseAssessment(ctx, &api.CloseAssessmentRequest{Id: a.Id, Abandoned: true}); err != nil {
+		t.Fatalf("Unable to close assessment: %s", err)
+	}
+
+	trend, err = s.GetComplianceTrend(ctx, &api.GetComplianceTrendRequest{
+		Control: "AC-2", Interval: api.TrendInterval_TREND_INTERVAL_WEEK,
+	})
+	if err != nil {
+		t.Fatalf("Unable to get compliance trend: %s", err)
+	}
+	monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	if assert.Len(t, trend.Points, 1) {
+		p := trend.Points[0]
+		assert.Equal(t, monday, p.Start.AsTime(), "expected %s got %s", monday, p.Start.AsTime())
+		assert.Equal(t, int64(3), p.Summary.Total, "expected %d got %d", 3, p.Summary.Total)
+		assert.Equal(t, int64(2), p.Summary.Passed, "expected %d got %d", 2, p.Summary.Passed)
+	}
+
+	// Nothing was reported before today
+	yesterday := timestamppb.New(today.Add(-time.Second))
+	trend, err = s.GetComplianceTrend(ctx, &api.GetComplianceTrendRequest{End: yesterday})
+	if err != nil {
+		t.Fatalf("Unable to get compliance trend: %s", err)
+	}
+	assert.Empty(t, trend.Points)
+
+	_, err = s.GetComplianceTrend(ctx, &api.GetComplianceTrendRequest{Start: timestamppb.Now(), End: yesterday})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+}
+
+func TestSetRuleMapsControls(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	rule, err := s.SetRule(ctx, &api.SetRuleRequest{
+		Name: "rule-1", Title: "Ensure accounts are reviewed", Severity: "high",
+		CheckMetadata: map[string]string{"benchmark": "CIS 1.1"}, Controls: []string{"AC-6", "AC-2"},
+	})
+	if err != nil {
+		t.Fatalf("Unable to set rule: %s", err)
+	}
+	expected := []string{"AC-2", "AC-6"}
+	assert.Equal(t, expected, rule.Controls, "expected %v got %v", expected, rule.Controls)
+
+	got, err := s.GetRule(ctx, &api.GetRuleRequest{Name: "rule-1"})
+	if err != nil {
+		t.Fatalf("Unable to get rule: %s", err)
+	}
+	assert.Equal(t, rule.Id, got.Id, "expected %s got %s", rule.Id, got.Id)
+	assert.Equal(t, "CIS 1.1", got.CheckMetadata["benchmark"], "expected %s got %s", "CIS 1.1",
+		got.CheckMetadata["benchmark"])
+
+	// A result reported for one control is evidence for every control the
+	// rule is mapped to
+	result, err := s.SetResult(ctx, &api.ResultRequest{
+		Subject: clusterName, Control: "AC-2", Rule: "rule-1", Outcome: "FAIL",
+	})
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	response, err := s.ListResults(ctx, &api.ListResultsRequest{Filter: &api.ResultFilter{Control: "AC-6"}})
+	if err != nil {
+		t.Fatalf("Unable to list results: %s", err)
+	}
+	if assert.Len(t, response.Results, 1) {
+		assert.Equal(t, result.Id, response.Results[0].Id, "expected %s got %s", result.Id, response.Results[0].Id)
+	}
+	posture, err := s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{Control: "AC-6"})
+	if err != nil {
+		t.Fatalf("Unable to query control posture: %s", err)
+	}
+	if assert.Len(t, posture.Subjects, 1) {
+		assert.Len(t, posture.Subjects[0].Results, 1)
+	}
+
+	// Rules reported by results are created and mapped to their control
+	if _, err := s.SetResult(ctx, &api.ResultRequest{
+		Subject: clusterName, Control: "AU-2", Rule: "rule-2", Outcome: "PASS",
+	}); err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+	rules, err := s.ListRules(ctx, &api.ListRulesRequest{Control: "AU-2"})
+	if err != nil {
+		t.Fatalf("Unable to list rules: %s", err)
+	}
+	if assert.Len(t, rules.Rules, 1) {
+		assert.Equal(t, "rule-2", rules.Rules[0].Name, "expected %s got %s", "rule-2", rules.Rules[0].Name)
+	}
+
+	_, err = s.GetRule(ctx, &api.GetRuleRequest{Name: "rule-3"})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, status.Code(err))
+}
+
+func TestQueryControlPostureWithCrosswalk(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	content := []byte("source,target,relationship,notes\n" +
+		"5.1.1,AC-2,equivalent-to,\n" +
+		"5.1.2,AC-6,subset-of,only covers cluster roles\n" +
+		"5.1.3,AC-3,no-relationship,\n")
+	imported, err := s.ImportControlMappings(ctx, &api.ImportControlMappingsRequest{
+		Format: api.MappingFormat_MAPPING_FORMAT_CSV, Content: content,
+	})
+	if err != nil {
+		t.Fatalf("Unable to import control mappings: %s", err)
+	}
+	assert.Equal(t, int64(2), imported.Mappings, "expected %d got %d", 2, imported.Mappings)
+
+	mappings, err := s.ListControlMappings(ctx, &api.ListControlMappingsRequest{Control: "AC-2"})
+	if err != nil {
+		t.Fatalf("Unable to list control mappings: %s", err)
+	}
+	if assert.Len(t, mappings.Mappings, 1) {
+		m := mappings.Mappings[0]
+		assert.Equal(t, "5.1.1", m.SourceControl, "expected %s got %s", "5.1.1", m.SourceControl)
+		assert.Equal(t, api.MappingRelationship_MAPPING_RELATIONSHIP_EQUIVALENT, m.Relationship,
+			"expected %s got %s", api.MappingRelationship_MAPPING_RELATIONSHIP_EQUIVALENT, m.Relationship)
+	}
+
+	// Results recorded against the benchmark answer posture for NIST
+	// controls
+	for _, r := range []*api.ResultRequest{
+		{Subject: clusterName, Control: "5.1.1", Rule: "rule-1", Outcome: "FAIL"},
+		{Subject: clusterName, Control: "5.1.2", Rule: "rule-2", Outcome: "PASS"},
+	} {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+	tests := []struct {
+		control   string
+		crosswalk api.Crosswalk
+		expected  []string
+	}{
+		{"AC-2", api.Crosswalk_CROSSWALK_UNSPECIFIED, nil},
+		{"AC-2", api.Crosswalk_CROSSWALK_EQUIVALENT, []string{"rule-1"}},
+		{"AC-6", api.Crosswalk_CROSSWALK_EQUIVALENT, nil},
+		{"AC-6", api.Crosswalk_CROSSWALK_PARTIAL, []string{"rule-2"}},
+		{"5.1.1", api.Crosswalk_CROSSWALK_EQUIVALENT, []string{"rule-1"}},
+	}
+	for _, tc := range tests {
+		response, err := s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{
+			Control: tc.control, Crosswalk: tc.crosswalk,
+		})
+		if err != nil {
+			t.Fatalf("Unable to query control posture: %s", err)
+		}
+		var rules []string
+		for _, p := range response.Subjects {
+			for _, r := range p.Results {
+				rules = append(rules, r.Rule)
+			}
+		}
+		assert.Equal(t, tc.expected, rules, "expected %v got %v for %s", tc.expected, rules, tc.control)
+	}
+
+	results, err := s.ListResults(ctx, &api.ListResultsRequest{
+		Filter: &api.ResultFilter{Control: "AC-2", Crosswalk: api.Crosswalk_CROSSWALK_EQUIVALENT},
+	})
+	if err != nil {
+		t.Fatalf("Unable to list results: %s", err)
+	}
+	assert.Len(t, results.Results, 1)
+
+	// OSCAL mapping collections need a catalog for every resource
+	_, err = s.ImportControlMappings(ctx, &api.ImportControlMappingsRequest{
+		Format: api.MappingFormat_MAPPING_FORMAT_OSCAL,
+		Content: []byte(`{"mapping-collection": {"uuid": "3e1f6c2a-9b4d-4f7e-8a15-2c6d9e0b4a71", "mappings": [{
+			"source-resource": {"type": "catalog", "href": "a.json"},
+			"target-resource": {"type": "catalog", "href": "b.json"},
+			"maps": [{
+				"relationship": "equivalent-to",
+				"sources": [{"type": "control", "id-ref": "ac-2"}],
+				"targets": [{"type": "control", "id-ref": "1.1"}]
+			}]
+		}]}}`),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+}
+
+func TestCrosswalkWithSharedLabels(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	s := api.NewServer(gormDB)
+	ctx := context.Background()
+
+	for _, r := range []*api.ResultRequest{
+		{Subject: clusterName, Control: "AC-2", Rule: "nist-rule", Outcome: "FAIL"},
+		{Subject: clusterName, Control: "SC-7", Rule: "pci-rule", Outcome: "FAIL"},
+	} {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+	// Both frameworks label a control 1.1
+	cisID, pciID := getUUIDString(), getUUIDString()
+	pciControlID := getUUIDString()
+	controls := map[string][2]string{cisID: {getUUIDString(), "AC-2"}, pciID: {pciControlID, "SC-7"}}
+	for catalogID, c := range controls {
+		err := gormDB.Exec("INSERT INTO catalogs (id, name, version) VALUES (?, ?, ?)", catalogID, catalogID, "1.0").Error
+		if err != nil {
+			t.Fatalf("Unable to create catalog: %s", err)
+		}
+		err = gormDB.Exec("INSERT INTO controls (id, name, catalog_id, oscal_id) VALUES (?, ?, ?, ?)",
+			c[0], "1.1", catalogID, "1.1").Error
+		if err != nil {
+			t.Fatalf("Unable to create control: %s", err)
+		}
+		_, err = s.ImportControlMappings(ctx, &api.ImportControlMappingsRequest{
+			Format:          api.MappingFormat_MAPPING_FORMAT_CSV,
+			Content:         []byte("source,target,relationship\n1.1," + c[1] + ",equivalent-to\n"),
+			SourceCatalogId: catalogID,
+		})
+		if err != nil {
+			t.Fatalf("Unable to import control mappings: %s", err)
+		}
+	}
+	var ruleID string
+	gormDB.Table("rules").Select("id").Where("name = ?", "pci-rule").Scan(&ruleID)
+	err := gormDB.Exec("INSERT INTO rule_controls (rule_id, control_id) VALUES (?, ?)", ruleID, pciControlID).Error
+	if err != nil {
+		t.Fatalf("Unable to map rule: %s", err)
+	}
+
+	tests := []struct {
+		control   string
+		catalogID string
+		expected  []string
+	}{
+		// The PCI rule is mapped to a control with the same label as the
+		// one AC-2 is crosswalked to, but not to the same control
+		{"AC-2", "", []string{"nist-rule"}},
+		{"1.1", cisID, []string{"nist-rule"}},
+		{"1.1", pciID, []string{"pci-rule"}},
+		{"1.1", "", []string{"nist-rule", "pci-rule"}},
+	}
+	for _, tc := range tests {
+		response, err := s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{
+			Control: tc.control, CatalogId: tc.catalogID, Crosswalk: api.Crosswalk_CROSSWALK_EQUIVALENT,
+		})
+		if err != nil {
+			t.Fatalf("Unable to query control posture: %s", err)
+		}
+		var rules []string
+		for _, p := range response.Subjects {
+			for _, r := range p.Results {
+				rules = append(rules, r.Rule)
+			}
+		}
+		assert.Equal(t, tc.expected, rules, "expected %v got %v for %s in %s", tc.expected, rules, tc.control, tc.catalogID)
+	}
+
+	results, err := s.ListResults(ctx, &api.ListResultsRequest{
+		Filter: &api.ResultFilter{Control: "1.1", CatalogId: pciID, Crosswalk: api.Crosswalk_CROSSWALK_EQUIVALENT},
+	})
+	if err != nil {
+		t.Fatalf("Unable to list results: %s", err)
+	}
+	if assert.Len(t, results.Results, 1) {
+		assert.Equal(t, "pci-rule", results.Results[0].Rule, "expected %s got %s", "pci-rule", results.Results[0].Rule)
+	}
+	_, err = s.ListResults(ctx, &api.ListResultsRequest{Filter: &api.ResultFilter{CatalogId: pciID}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+	_, err = s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{Control: "1.1", CatalogId: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+}
+
+func TestControlHierarchyRollsUp(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	gormDB := getGormHelper()
+	s := api.NewServer(gormDB)
+	ctx := context.Background()
+
+	content, err := os.ReadFile(testCatalogPath)
+	if err != nil {
+		t.Fatalf("Unable to read catalog: %s", err)
+	}
+	if _, err := s.ImportCatalog(ctx, &api.ImportCatalogRequest{Content: content}); err != nil {
+		t.Fatalf("Unable to import catalog: %s", err)
+	}
+
+	// Importing the catalog links enhancements to their base control and
+	// every control to its family
+	var parents, families []string
+	gormDB.Table("controls").Where("name = ?", "AC-2(1)").Pluck("parent_id", &parents)
+	expected := []string{}
+	gormDB.Table("controls").Where("name = ?", "AC-2").Pluck("id", &expected)
+	assert.Equal(t, expected, parents, "expected %v got %v", expected, parents)
+	gormDB.Table("controls").Joins("JOIN control_families ON control_families.id = controls.family_id").
+		Where("controls.name IN ?", []string{"AC-2", "AC-2(1)"}).Pluck("control_families.title", &families)
+	expected = []string{"Access Control", "Access Control"}
+	assert.Equal(t, expected, families, "expected %v got %v", expected, families)
+
+	for _, r := range []*api.ResultRequest{
+		{Subject: clusterName, Control: "AC-2", Rule: "rule-1", Outcome: "PASS"},
+		{Subject: clusterName, Control: "AC-2(1)", Rule: "rule-2", Outcome: "FAIL"},
+		{Subject: clusterName, Control: "au-2", Rule: "rule-3", Outcome: "PASS"},
+		{Subject: clusterName, Control: "CM-6", Rule: "rule-4", Outcome: "PASS"},
+	} {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+
+	// Families are ordered like the catalog, controls outside of a
+	// catalog come last
+	summary, err := s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{
+		GroupBy: api.SummaryGrouping_SUMMARY_GROUPING_FAMILY,
+	})
+	if err != nil {
+		t.Fatalf("Unable to get compliance summary: %s", err)
+	}
+	if assert.Len(t, summary.Groups, 3) {
+		ac := summary.Groups[0]
+		assert.Equal(t, "Access Control", ac.Name, "expected %s got %s", "Access Control", ac.Name)
+		assert.InDelta(t, 50.0, ac.PassPercentage, 0.001)
+		au := summary.Groups[1]
+		assert.Equal(t, "Audit and Accountability", au.Name, "expected %s got %s", "Audit and Accountability", au.Name)
+		assert.Empty(t, summary.Groups[2].Key)
+	}
+
+	// An enhancement failure counts against its base control
+	summary, err = s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{
+		GroupBy: api.SummaryGrouping_SUMMARY_GROUPING_CONTROL,
+	})
+	if err != nil {
+		t.Fatalf("Unable to get compliance summary: %s", err)
+	}
+	var names []string
+	for _, g := range summary.Groups {
+		names = append(names, g.Name)
+	}
+	assert.Equal(t, []string{"AC-2", "au-2", "CM-6"}, names, "expected %v got %v", []string{"AC-2", "au-2", "CM-6"}, names)
+	if assert.NotEmpty(t, summary.Groups) {
+		assert.Equal(t, int64(1), summary.Groups[0].Failed, "expected %d got %d", 1, summary.Groups[0].Failed)
+	}
+
+	tests := []struct {
+		request  *api.QueryControlPostureRequest
+		expected []string
+	}{
+		{&api.QueryControlPostureRequest{Control: "AC-2"}, []string{"rule-1", "rule-2"}},
+		{&api.QueryControlPostureRequest{Control: "AC-2(1)"}, []string{"rule-2"}},
+		{&api.QueryControlPostureRequest{Family: "ac"}, []string{"rule-1", "rule-2"}},
+		{&api.QueryControlPostureRequest{Family: "Audit and Accountability"}, []string{"rule-3"}},
+	}
+	for _, tc := range tests {
+		response, err := s.QueryControlPosture(ctx, tc.request)
+		if err != nil {
+			t.Fatalf("Unable to query control posture: %s", err)
+		}
+		var rules []string
+		for _, p := range response.Subjects {
+			for _, r := range p.Results {
+				rules = append(rules, r.Rule)
+			}
+		}
+		sort.Strings(rules)
+		assert.Equal(t, tc.expected, rules, "expected %v got %v for %v", tc.expected, rules, tc.request)
+	}
+
+	_, err = s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{Control: "AC-2", Family: "ac"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, status.Code(err))
+
+	results, err := s.ListResults(ctx, &api.ListResultsRequest{Filter: &api.ResultFilter{Family: "au"}})
+	if err != nil {
+		t.Fatalf("Unable to list results: %s", err)
+	}
+	assert.Len(t, results.Results, 1)
+}
+
+func TestHealthServerReportsDatabase(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service := api.ComplianceService_ServiceDesc.ServiceName
+
+	hs := api.NewHealthServer(ctx, getGormHelper(), time.Hour)
+	for _, name := range []string{"", service} {
+		response, err := hs.Check(ctx, &healthpb.HealthCheckRequest{Service: name})
+		if err != nil {
+			t.Fatalf("Unable to check health of %q: %s", name, err)
+		}
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, response.Status,
+			"expected %s got %s for %q", healthpb.HealthCheckResponse_SERVING, response.Status, name)
+	}
+
+	// The service isn't ready without a database, but the server is
+	// still live
+	gormDB := getGormHelper()
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		t.Fatalf("Unable to get database connection: %s", err)
+	}
+	sqlDB.Close()
+	hs = api.NewHealthServer(ctx, gormDB, time.Hour)
+	response, err := hs.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Unable to check health: %s", err)
+	}
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, response.Status,
+		"expected %s got %s", healthpb.HealthCheckResponse_NOT_SERVING, response.Status)
+	response, err = hs.Check(ctx, &healthpb.HealthCheckRequest{Service: ""})
+	if err != nil {
+		t.Fatalf("Unable to check health: %s", err)
+	}
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, response.Status,
+		"expected %s got %s", healthpb.HealthCheckResponse_SERVING, response.Status)
+}
+
+func TestRESTGateway(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	url := getGatewayHelper(t)
+
+	call := func(method, path, body string, response proto.Message) int {
+		t.Helper()
+		req, err := http.NewRequestWithContext(context.Background(), method, url+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Unable to create request: %s", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Unable to call %s %s: %s", method, path, err)
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Unable to read response: %s", err)
+		}
+		if response != nil {
+			if err := protojson.Unmarshal(b, response); err != nil {
+				t.Fatalf("Unable to decode response %s: %s", b, err)
+			}
+		}
+		return resp.StatusCode
+	}
+
+	subject := &api.Subject{}
+	code := call(http.MethodPost, "/v1/subjects", `{"name": "`+clusterName+`", "type": "cluster"}`, subject)
+	assert.Equal(t, http.StatusOK, code, "expected %d got %d", http.StatusOK, code)
+	found := &api.Subject{}
+	code = call(http.MethodGet, "/v1/subjects/"+subject.Id, "", found)
+	assert.Equal(t, http.StatusOK, code, "expected %d got %d", http.StatusOK, code)
+	assert.Equal(t, clusterName, found.Name, "expected %s got %s", clusterName, found.Name)
+
+	result := &api.ResultResponse{}
+	body := `{"subject": "` + clusterName + `", "control": "AC-2", "rule": "rule-1", "outcome": "PASS"}`
+	code = call(http.MethodPost, "/v1/results", body, result)
+	assert.Equal(t, http.StatusOK, code, "expected %d got %d", http.StatusOK, code)
+	results := &api.ListResultsResponse{}
+	code = call(http.MethodGet, "/v1/results?filter.subjectId="+subject.Id, "", results)
+	assert.Equal(t, http.StatusOK, code, "expected %d got %d", http.StatusOK, code)
+	if assert.Len(t, results.Results, 1) {
+		assert.Equal(t, result.Id, results.Results[0].Id, "expected %s got %s", result.Id, results.Results[0].Id)
+	}
+
+	// Errors keep their details
+	st := &statuspb.Status{}
+	code = call(http.MethodPost, "/v1/subjects", `{"type": "cluster"}`, st)
+	assert.Equal(t, http.StatusBadRequest, code, "expected %d got %d", http.StatusBadRequest, code)
+	assert.Equal(t, int32(codes.InvalidArgument), st.Code, "expected %d got %d", codes.InvalidArgument, st.Code)
+	assert.Len(t, st.Details, 1)
+	code = call(http.MethodGet, "/v1/subjects/"+getUUIDString(), "", nil)
+	assert.Equal(t, http.StatusNotFound, code, "expected %d got %d", http.StatusNotFound, code)
+
+	doc := &structpb.Struct{}
+	code = call(http.MethodGet, "/openapi.json", "", doc)
+	assert.Equal(t, http.StatusOK, code, "expected %d got %d", http.StatusOK, code)
+	assert.Contains(t, doc.Fields["paths"].GetStructValue().GetFields(), "/v1/results")
+}
+
+func TestExportResults(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	client := getClientHelper(t)
+	ctx := context.Background()
+
+	a, err := client.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "nightly scan"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	// Enough results for the export to be sent in several chunks
+	stream, err := client.SetResults(ctx)
+	if err != nil {
+		t.Fatalf("Unable to open stream: %s", err)
+	}
+	count := 500
+	for i := 0; i < count; i++ {
+		outcome := "PASS"
+		if i%2 == 1 {
+			outcome = "FAIL"
+		}
+		r := &api.ResultRequest{
+			Subject: "node-1", Control: "AC-2", Rule: fmt.Sprintf("rule-%03d", i), Outcome: outcome,
+			AssessmentId: a.Id, Description: strings.Repeat("x", 200),
+		}
+		if err := stream.Send(r); err != nil {
+			t.Fatalf("Unable to send result: %s", err)
+		}
+	}
+	if _, err := stream.CloseAndRecv(); err != nil {
+		t.Fatalf("Unable to close stream: %s", err)
+	}
+
+	export := func(request *api.ExportResultsRequest) []string {
+		t.Helper()
+		stream, err := client.ExportResults(ctx, request)
+		if err != nil {
+			t.Fatalf("Unable to export results: %s", err)
+		}
+		var chunks []string
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return chunks
+			}
+			if err != nil {
+				t.Fatalf("Unable to receive export: %s", err)
+			}
+			chunks = append(chunks, string(chunk.Data))
+		}
+	}
+
+	chunks := export(&api.ExportResultsRequest{
+		Filter: &api.ResultFilter{AssessmentId: a.Id}, Format: api.ExportFormat_EXPORT_FORMAT_JSONL,
+	})
+	assert.Greater(t, len(chunks), 1, "expected several chunks got %d", len(chunks))
+	var lines []string
+	for _, chunk := range chunks {
+		// Chunks end with a complete row
+		assert.True(t, strings.HasSuffix(chunk, "\n"))
+		lines = append(lines, strings.Split(strings.TrimSuffix(chunk, "\n"), "\n")...)
+	}
+	assert.Equal(t, count, len(lines), "expected %d got %d", count, len(lines))
+	row := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(lines[0]), &row); err != nil {
+		t.Fatalf("Unable to decode row: %s", err)
+	}
+	assert.Equal(t, "node-1", row["subject"], "expected %s got %s", "node-1", row["subject"])
+	assert.Equal(t, "nightly scan", row["assessment"], "expected %s got %s", "nightly scan", row["assessment"])
+	assert.Equal(t, "IN_PROGRESS", row["assessmentState"], "expected %s got %s", "IN_PROGRESS", row["assessmentState"])
+	assert.Nil(t, row["assessmentFinishedAt"])
+
+	chunks = export(&api.ExportResultsRequest{
+		Filter:  &api.ResultFilter{AssessmentId: a.Id, Outcome: "FAIL"},
+		Columns: []string{"rule", "control", "outcome"},
+	})
+	records, err := csv.NewReader(strings.NewReader(strings.Join(chunks, ""))).ReadAll()
+	if err != nil {
+		t.Fatalf("Unable to decode CSV: %s", err)
+	}
+	assert.Equal(t, count/2+1, len(records), "expected %d got %d", count/2+1, len(records))
+	assert.Equal(t, []string{"rule", "control", "outcome"}, records[0])
+	rows := records[1:]
+	sort.Slice(rows, func(i, j int) bool { return rows[i][0] < rows[j][0] })
+	assert.Equal(t, []string{"rule-001", "AC-2", "FAIL"}, rows[0])
+
+	// The same export can be downloaded over HTTP
+	url := getGatewayHelper(t)
+	get := func(path string) (*http.Response, string) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+path, nil)
+		if err != nil {
+			t.Fatalf("Unable to create request: %s", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Unable to call GET %s: %s", path, err)
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Unable to read response: %s", err)
+		}
+		return resp, string(b)
+	}
+	resp, body := get("/v1/results:export?columns=rule&columns=outcome&filter.outcome=FAIL&filter.assessmentId=" + a.Id)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "expected %d got %d", http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Equal(t, count/2+1, strings.Count(body, "\n"), "expected %d got %d", count/2+1, strings.Count(body, "\n"))
+
+	resp, _ = get("/v1/results:export?columns=password")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "expected %d got %d", http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestExceptionsReportFailuresAsExcepted(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	cluster, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: clusterName, Type: "cluster"})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	node, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "node-1", Type: "node", ParentId: cluster.Id})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	_, err = s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: "node-2", Type: "node", ParentId: cluster.Id})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	for _, r := range []*api.ResultRequest{
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-1", Outcome: "FAIL"},
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-3", Outcome: "PASS"},
+		{Subject: "node-2", Control: "AC-2", Rule: "rule-1", Outcome: "FAIL"},
+		{Subject: "node-2", Control: "AU-2", Rule: "rule-2", Outcome: "FAIL"},
+	} {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+
+	expires := timestamppb.New(time.Now().Add(24 * time.Hour))
+	for _, r := range []*api.CreateExceptionRequest{
+		{SubjectId: node.Id, Control: "AC-2", Rule: "rule-1", Justification: "j", Approver: "a", ExpiresAt: expires},
+		{SubjectId: node.Id, Rule: "rule-9", Justification: "j", Approver: "a", ExpiresAt: expires},
+		{SubjectId: node.Id, Control: "AC-22", Justification: "j", Approver: "a", ExpiresAt: expires},
+		{SubjectId: node.Id, Control: "AC-2", Justification: "j", Approver: "a",
+			ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour))},
+	} {
+		_, err := s.CreateException(ctx, r)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, err)
+	}
+
+	// The control exception only covers node-1, while the rule
+	// exception covers every node in the cluster.
+	control, err := s.CreateException(ctx, &api.CreateExceptionRequest{
+		SubjectId: node.Id, Control: "AC-2", Justification: "Accounts are managed by the IdP",
+		Approver: "security@example.com", ExpiresAt: expires,
+	})
+	if err != nil {
+		t.Fatalf("Unable to create exception: %s", err)
+	}
+	assert.Equal(t, api.ExceptionState_EXCEPTION_STATE_ACTIVE, control.State)
+	rule, err := s.CreateException(ctx, &api.CreateExceptionRequest{
+		SubjectId: cluster.Id, Rule: "rule-2", Justification: "Compensating control",
+		Approver: "security@example.com", ExpiresAt: expires,
+	})
+	if err != nil {
+		t.Fatalf("Unable to create exception: %s", err)
+	}
+
+	summarize := func() *api.ComplianceSummary {
+		t.Helper()
+		response, err := s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{RootSubjectId: cluster.Id})
+		if err != nil {
+			t.Fatalf("Unable to get compliance summary: %s", err)
+		}
+		return response.Overall
+	}
+	overall := summarize()
+	assert.Equal(t, int64(2), overall.Excepted, "expected 2 got %d", overall.Excepted)
+	assert.Equal(t, int64(1), overall.Failed, "expected 1 got %d", overall.Failed)
+	assert.Equal(t, int64(2), overall.Outcomes["EXCEPTED"], "expected 2 got %d", overall.Outcomes["EXCEPTED"])
+	assert.Equal(t, 50.0, overall.PassPercentage, "expected 50 got %v", overall.PassPercentage)
+
+	response, err := s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{
+		Control: "AC-2", RootSubjectId: cluster.Id, Outcome: "EXCEPTED",
+	})
+	if err != nil {
+		t.Fatalf("Unable to query control posture: %s", err)
+	}
+	if assert.Len(t, response.Subjects, 1) && assert.Len(t, response.Subjects[0].Results, 2) {
+		assert.Equal(t, "node-1", response.Subjects[0].Subject.Name)
+		r := response.Subjects[0].Results[0]
+		assert.Equal(t, "EXCEPTED", r.Outcome, "expected EXCEPTED got %s", r.Outcome)
+		assert.Equal(t, control.Id, r.ExceptionId, "expected %s got %s", control.Id, r.ExceptionId)
+	}
+
+	// Revoked and expired exceptions no longer cover failures
+	revoked, err := s.RevokeException(ctx, &api.RevokeExceptionRequest{Id: rule.Id})
+	if err != nil {
+		t.Fatalf("Unable to revoke exception: %s", err)
+	}
+	assert.Equal(t, api.ExceptionState_EXCEPTION_STATE_REVOKED, revoked.State)
+	_, err = s.RevokeException(ctx, &api.RevokeExceptionRequest{Id: rule.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "expected %s got %s", codes.FailedPrecondition, err)
+	overall = summarize()
+	assert.Equal(t, int64(1), overall.Excepted, "expected 1 got %d", overall.Excepted)
+	assert.Equal(t, int64(2), overall.Failed, "expected 2 got %d", overall.Failed)
+
+	err = getGormHelper().Exec("UPDATE exceptions SET expires_at = ? WHERE id = ?",
+		time.Now().UTC().Add(-time.Minute), control.Id).Error
+	if err != nil {
+		t.Fatalf("Unable to expire exception: %s", err)
+	}
+	overall = summarize()
+	assert.Equal(t, int64(0), overall.Excepted, "expected 0 got %d", overall.Excepted)
+	assert.Equal(t, int64(3), overall.Failed, "expected 3 got %d", overall.Failed)
+	expired, err := s.GetException(ctx, &api.GetExceptionRequest{Id: control.Id})
+	if err != nil {
+		t.Fatalf("Unable to get exception: %s", err)
+	}
+	assert.Equal(t, api.ExceptionState_EXCEPTION_STATE_EXPIRED, expired.State)
+
+	list, err := s.ListExceptions(ctx, &api.ListExceptionsRequest{State: api.ExceptionState_EXCEPTION_STATE_REVOKED})
+	if err != nil {
+		t.Fatalf("Unable to list exceptions: %s", err)
+	}
+	if assert.Len(t, list.Exceptions, 1) {
+		assert.Equal(t, "rule-2", list.Exceptions[0].Rule)
+	}
+	list, err = s.ListExceptions(ctx, &api.ListExceptionsRequest{State: api.ExceptionState_EXCEPTION_STATE_ACTIVE})
+	if err != nil {
+		t.Fatalf("Unable to list exceptions: %s", err)
+	}
+	assert.Empty(t, list.Exceptions)
+}
+
+func TestEvidence(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	client := getClientHelper(t)
+	ctx := context.Background()
+
+	subject, err := client.CreateSubject(ctx, &api.CreateSubjectRequest{Name: clusterName, Type: "cluster"})
+	if err != nil {
+		t.Fatalf("Unable to create subject: %s", err)
+	}
+	result, err := client.SetResult(ctx, &api.ResultRequest{
+		Subject: clusterName, Control: "AC-2", Rule: "rule-1", Outcome: "FAIL",
+	})
+	if err != nil {
+		t.Fatalf("Unable to set result: %s", err)
+	}
+
+	// Uploads are sent in chunks, with the metadata in the first one.
+	upload := func(metadata *api.EvidenceMetadata, contents []byte, chunkSize int) (*api.Evidence, error) {
+		t.Helper()
+		stream, err := client.UploadEvidence(ctx)
+		if err != nil {
+			t.Fatalf("Unable to open stream: %s", err)
+		}
+		request := &api.UploadEvidenceRequest{Metadata: metadata}
+		for len(contents) > 0 || request.Metadata != nil {
+			n := chunkSize
+			if n > len(contents) {
+				n = len(contents)
+			}
+			request.Data, contents = contents[:n], contents[n:]
+			if err := stream.Send(request); err != nil {
+				break
+			}
+			request = &api.UploadEvidenceRequest{}
+		}
+		return stream.CloseAndRecv()
+	}
+	contents := []byte(strings.Repeat("PermitRootLogin yes\n", 5000))
+	digest := sha256.Sum256(contents)
+	e, err := upload(&api.EvidenceMetadata{
+		ResultId: result.Id, Name: "sshd_config", ContentType: "text/plain", Sha256: hex.EncodeToString(digest[:]),
+	}, contents, 16*1024)
+	if err != nil {
+		t.Fatalf("Unable to upload evidence: %s", err)
+	}
+	assert.Equal(t, int64(len(contents)), e.Size, "expected %d got %d", len(contents), e.Size)
+	assert.Equal(t, hex.EncodeToString(digest[:]), e.Sha256)
+	assert.Equal(t, result.Id, e.ResultId, "expected %s got %s", result.Id, e.ResultId)
+
+	found, err := client.GetEvidence(ctx, &api.GetEvidenceRequest{Id: e.Id})
+	if err != nil {
+		t.Fatalf("Unable to get evidence: %s", err)
+	}
+	assert.True(t, proto.Equal(e, found), "expected %v got %v", e, found)
+
+	download, err := client.DownloadEvidence(ctx, &api.DownloadEvidenceRequest{Id: e.Id})
+	if err != nil {
+		t.Fatalf("Unable to download evidence: %s", err)
+	}
+	var downloaded []byte
+	for {
+		chunk, err := download.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatalf("Unable to download evidence: %s", err)
+		}
+		if len(downloaded) == 0 {
+			assert.Equal(t, e.Id, chunk.GetEvidence().GetId(), "expected the first chunk to describe the evidence")
+		}
+		downloaded = append(downloaded, chunk.Data...)
+	}
+	assert.Equal(t, contents, downloaded)
+
+	// Evidence that doesn't match its digest, is too large, or isn't
+	// attached to anything is rejected.
+	for _, tc := range []struct {
+		metadata *api.EvidenceMetadata
+		contents []byte
+	}{
+		{&api.EvidenceMetadata{ResultId: result.Id, Name: "a", ContentType: "text/plain", Sha256: strings.Repeat("0", 64)},
+			[]byte("contents")},
+		{&api.EvidenceMetadata{ResultId: result.Id, Name: "a", ContentType: "text/plain"},
+			make([]byte, maxEvidenceSize+1)},
+		{&api.EvidenceMetadata{ResultId: getUUIDString(), Name: "a", ContentType: "text/plain"}, []byte("contents")},
+		{&api.EvidenceMetadata{Name: "a", ContentType: "text/plain"}, []byte("contents")},
+		{&api.EvidenceMetadata{ResultId: result.Id, Name: "a", ContentType: "not a type"}, []byte("contents")},
+	} {
+		_, err := upload(tc.metadata, tc.contents, 64*1024)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, err)
+	}
+	list, err := client.ListEvidence(ctx, &api.ListEvidenceRequest{ResultId: result.Id})
+	if err != nil {
+		t.Fatalf("Unable to list evidence: %s", err)
+	}
+	assert.Len(t, list.Evidence, 1)
+
+	// Evidence can be uploaded and downloaded over HTTP too.
+	a, err := client.OpenAssessment(ctx, &api.OpenAssessmentRequest{Name: "manual review"})
+	if err != nil {
+		t.Fatalf("Unable to open assessment: %s", err)
+	}
+	url := getGatewayHelper(t)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		url+"/v1/evidence?name=screenshot.png&assessmentId="+a.Id, strings.NewReader("\x89PNG"))
+	if err != nil {
+		t.Fatalf("Unable to create request: %s", err)
+	}
+	req.Header.Set("Content-Type", "image/png")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Unable to upload evidence: %s", err)
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("Unable to read response: %s", err)
+	}
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "expected %d got %d", http.StatusOK, resp.StatusCode)
+	screenshot := &api.Evidence{}
+	if err := protojson.Unmarshal(b, screenshot); err != nil {
+		t.Fatalf("Unable to decode response %s: %s", b, err)
+	}
+	assert.Equal(t, "image/png", screenshot.ContentType, "expected image/png got %s", screenshot.ContentType)
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, url+"/v1/evidence/"+screenshot.Id+":download", nil)
+	if err != nil {
+		t.Fatalf("Unable to create request: %s", err)
+	}
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Unable to download evidence: %s", err)
+	}
+	b, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("Unable to read response: %s", err)
+	}
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "expected %d got %d", http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=screenshot.png`, resp.Header.Get("Content-Disposition"))
+	assert.Equal(t, "\x89PNG", string(b))
+
+	_, err = client.DeleteEvidence(ctx, &api.DeleteEvidenceRequest{Id: screenshot.Id})
+	if err != nil {
+		t.Fatalf("Unable to delete evidence: %s", err)
+	}
+	download, err = client.DownloadEvidence(ctx, &api.DownloadEvidenceRequest{Id: screenshot.Id})
+	if err != nil {
+		t.Fatalf("Unable to download evidence: %s", err)
+	}
+	_, err = download.Recv()
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, err)
+
+	// Deleting a subject deletes the evidence of its results.
+	_, err = client.DeleteSubject(ctx, &api.DeleteSubjectRequest{Id: subject.Id, Cascade: true})
+	if err != nil {
+		t.Fatalf("Unable to delete subject: %s", err)
+	}
+	_, err = client.GetEvidence(ctx, &api.GetEvidenceRequest{Id: e.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected %s got %s", codes.NotFound, err)
+}
+
+func TestSubjectLabelSelectors(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	if err := m.Up(); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	s := api.NewServer(getGormHelper())
+	ctx := context.Background()
+
+	create := func(name, subjectType, parentID string, labels map[string]string) *api.Subject {
+		t.Helper()
+		subject, err := s.CreateSubject(ctx, &api.CreateSubjectRequest{Name: name, Type: subjectType, ParentId: parentID})
+		if err != nil {
+			t.Fatalf("Unable to create subject: %s", err)
+		}
+		if len(labels) == 0 {
+			return subject
+		}
+		subject, err = s.SetSubjectLabels(ctx, &api.SetSubjectLabelsRequest{Id: subject.Id, Labels: labels})
+		if err != nil {
+			t.Fatalf("Unable to set labels: %s", err)
+		}
+		return subject
+	}
+	// Nodes inherit the labels of their cluster unless they override them.
+	prod := create("cluster-a", "cluster", "", map[string]string{"env": "prod", "team": "payments"})
+	assert.Equal(t, map[string]string{"env": "prod", "team": "payments"}, prod.Labels)
+	node1 := create("node-1", "node", prod.Id, nil)
+	node2 := create("node-2", "node", prod.Id, map[string]string{"env": "staging"})
+	staging := create("cluster-b", "cluster", "", map[string]string{"env": "staging"})
+
+	list := func(selector string) []string {
+		t.Helper()
+		response, err := s.ListSubjects(ctx, &api.ListSubjectsRequest{LabelSelector: selector})
+		if err != nil {
+			t.Fatalf("Unable to list subjects matching %s: %s", selector, err)
+		}
+		var names []string
+		for _, subject := range response.Subjects {
+			names = append(names, subject.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+	assert.Equal(t, []string{"cluster-a", "node-1"}, list("env=prod"))
+	assert.Equal(t, []string{"cluster-a", "node-1"}, list("env=prod,team in (payments)"))
+	assert.Equal(t, []string{"cluster-b", "node-2"}, list("env notin (prod)"))
+	assert.Equal(t, []string{"cluster-b"}, list("!team"))
+	_, err := s.ListSubjects(ctx, &api.ListSubjectsRequest{LabelSelector: "env in prod"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, err)
+	_, err = s.SetSubjectLabels(ctx, &api.SetSubjectLabelsRequest{Id: node1.Id, Labels: map[string]string{"bad key": "x"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected %s got %s", codes.InvalidArgument, err)
+
+	for _, r := range []*api.ResultRequest{
+		{Subject: "node-1", Control: "AC-2", Rule: "rule-1", Outcome: "FAIL"},
+		{Subject: "node-2", Control: "AC-2", Rule: "rule-1", Outcome: "PASS"},
+		{Subject: "cluster-b", Control: "AC-2", Rule: "rule-1", Outcome: "FAIL"},
+	} {
+		if _, err := s.SetResult(ctx, r); err != nil {
+			t.Fatalf("Unable to set result: %s", err)
+		}
+	}
+
+	summary, err := s.GetComplianceSummary(ctx, &api.GetComplianceSummaryRequest{
+		Filter: &api.ResultFilter{LabelSelector: "env=prod"},
+	})
+	if err != nil {
+		t.Fatalf("Unable to get compliance summary: %s", err)
+	}
+	assert.Equal(t, int64(1), summary.Overall.Total, "expected 1 got %d", summary.Overall.Total)
+	assert.Equal(t, int64(1), summary.Overall.Failed, "expected 1 got %d", summary.Overall.Failed)
+
+	posture, err := s.QueryControlPosture(ctx, &api.QueryControlPostureRequest{
+		Control: "AC-2", LabelSelector: "team=payments",
+	})
+	if err != nil {
+		t.Fatalf("Unable to query control posture: %s", err)
+	}
+	if assert.Len(t, posture.Subjects, 2) {
+		for _, p := range posture.Subjects {
+			assert.Contains(t, []string{node1.Id, node2.Id}, p.Subject.Id)
+		}
+	}
+
+	// Removing a label from the cluster removes it from its nodes.
+	cluster, err := s.RemoveSubjectLabels(ctx, &api.RemoveSubjectLabelsRequest{Id: prod.Id, Keys: []string{"team"}})
+	if err != nil {
+		t.Fatalf("Unable to remove labels: %s", err)
+	}
+	assert.Equal(t, map[string]string{"env": "prod"}, cluster.Labels)
+	results, err := s.ListResults(ctx, &api.ListResultsRequest{Filter: &api.ResultFilter{LabelSelector: "team"}})
+	if err != nil {
+		t.Fatalf("Unable to list results: %s", err)
+	}
+	assert.Len(t, results.Results, 0)
+
+	// Labels are deleted along with their subject.
+	_, err = s.DeleteSubject(ctx, &api.DeleteSubjectRequest{Id: staging.Id, Cascade: true})
+	if err != nil {
+		t.Fatalf("Unable to delete subject: %s", err)
+	}
+	assert.Equal(t, []string{"node-2"}, list("env=staging"))
+}
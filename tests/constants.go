@@ -1,3 +1,18 @@
 package tests
 
 const clusterName = "cluster.example.com"
+
+// testCatalogPath is a small OSCAL catalog shared with the unit tests.
+const testCatalogPath = "../pkg/oscal/testdata/catalog.json"
+
+// testProfilePath is a small OSCAL profile that imports testCatalogPath
+// through a back matter resource.
+const testProfilePath = "../pkg/oscal/testdata/profile.json"
+
+// testProfileCatalogHref is the link of the back matter resource the test
+// profile imports.
+const testProfileCatalogHref = "https://example.com/catalog.json"
+
+// maxEvidenceSize is the largest evidence the test server accepts, small
+// enough that tests can exceed it cheaply.
+const maxEvidenceSize = 256 * 1024
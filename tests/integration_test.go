@@ -303,7 +303,7 @@ func TestMigration(t *testing.T) { // nolint:paralleltest // database tests shou
 	// Upgrade the database and make sure all upgrades apply cleanly.
 	err = m.Up()
 	version, dirty, _ = m.Version()
-	expectedVersion = uint(9)
+	expectedVersion = uint(24)
 	assert.Equal(t, expectedVersion, version, "Database version mismatch: want %d but got %d", expectedVersion, version)
 	assert.Equal(t, false, dirty, "Database state mismatch: want %t but got %t", false, dirty)
 	assert.Equal(t, err, nil, "Error upgrading the database: %s", err)
@@ -601,3 +601,736 @@ func TestInsertResultSucceeds(t *testing.T) { // nolint:paralleltest // database
 	assert.Equal(t, e.SubjectID, a.SubjectID, "expected %s got %s", e.SubjectID, a.SubjectID)
 	assert.Equal(t, e.AssessmentID, a.AssessmentID, "expected %s got %s", e.AssessmentID, a.AssessmentID)
 }
+
+func TestResultIndexesMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type results struct{}
+	indexes := []string{
+		"idx_results_subject_id", "idx_results_control_id",
+		"idx_results_assessment_id", "idx_results_metadata_id",
+	}
+
+	if err := m.Migrate(9); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range indexes {
+		result := gormDB.Migrator().HasIndex(&results{}, s)
+		assert.False(t, result, "Index exists prior to migration: %s", s)
+	}
+
+	if err := m.Migrate(10); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range indexes {
+		result := gormDB.Migrator().HasIndex(&results{}, s)
+		assert.True(t, result, "Index doesn't exist: %s", s)
+	}
+
+	// Ensure the indexes are removed on downgrade
+	if err := m.Migrate(9); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	for _, s := range indexes {
+		result := gormDB.Migrator().HasIndex(&results{}, s)
+		assert.False(t, result, "Index exists after downgrade: %s", s)
+	}
+}
+
+func TestAssessmentStateMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type assessments struct{}
+	columns := []string{"state", "started_at", "finished_at"}
+	constraintName := "chk_assessments_state"
+
+	if err := m.Migrate(10); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	// Create an assessment before the upgrade to make sure existing
+	// assessments are completed.
+	id, err := insertAssessment()
+	if err != nil {
+		t.Fatalf("Unable to create assessment: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&assessments{}, s)
+		assert.False(t, result, "Column exists prior to migration: %s", s)
+	}
+
+	if err := m.Migrate(11); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&assessments{}, s)
+		assert.True(t, result, "Column doesn't exist: %s", s)
+	}
+	result := gormDB.Migrator().HasConstraint(&assessments{}, constraintName)
+	assert.True(t, result, "Table doesn't have constraint: %s", constraintName)
+
+	var state string
+	gormDB.Table("assessments").Select("state").Where("id = ?", id).Scan(&state)
+	assert.Equal(t, "COMPLETED", state, "expected %s got %s", "COMPLETED", state)
+
+	err = gormDB.Exec("UPDATE assessments SET state = 'UNKNOWN' WHERE id = ?", id).Error
+	assert.NotEmpty(t, err, "Shouldn't be able to set an invalid assessment state")
+
+	if err := m.Migrate(10); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&assessments{}, s)
+		assert.False(t, result, "Column exists after downgrade: %s", s)
+	}
+}
+
+func TestCatalogControlsMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type catalogs struct{}
+	type controls struct{}
+	columns := []string{"catalog_id", "oscal_id", "title"}
+
+	if err := m.Migrate(11); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result := gormDB.Migrator().HasColumn(&catalogs{}, "version")
+	assert.False(t, result, "Column exists prior to migration: %s", "version")
+	for _, s := range columns {
+		result = gormDB.Migrator().HasColumn(&controls{}, s)
+		assert.False(t, result, "Column exists prior to migration: %s", s)
+	}
+
+	if err := m.Migrate(12); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasColumn(&catalogs{}, "version")
+	assert.True(t, result, "Column doesn't exist: %s", "version")
+	for _, s := range columns {
+		result = gormDB.Migrator().HasColumn(&controls{}, s)
+		assert.True(t, result, "Column doesn't exist: %s", s)
+	}
+	for _, s := range []string{"fk_controls_catalog_id", "uq_controls_catalog_id_oscal_id"} {
+		result = gormDB.Migrator().HasConstraint(&controls{}, s)
+		assert.True(t, result, "Table doesn't have constraint: %s", s)
+	}
+	constraintName := "uq_catalogs_name_version"
+	result = gormDB.Migrator().HasConstraint(&catalogs{}, constraintName)
+	assert.True(t, result, "Table doesn't have constraint: %s", constraintName)
+
+	if err := m.Migrate(11); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasColumn(&catalogs{}, "version")
+	assert.False(t, result, "Column exists after downgrade: %s", "version")
+	for _, s := range columns {
+		result = gormDB.Migrator().HasColumn(&controls{}, s)
+		assert.False(t, result, "Column exists after downgrade: %s", s)
+	}
+}
+
+func TestProfileResolutionMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type profiles struct{}
+	tableName := "profile_catalogs"
+	type controls struct{}
+	columns := []string{"version", "content", "parameters"}
+
+	if err := m.Migrate(12); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&profiles{}, s)
+		assert.False(t, result, "Column exists prior to migration: %s", s)
+	}
+	result := gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists prior to migration: %s", tableName)
+
+	if err := m.Migrate(13); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result = gormDB.Migrator().HasColumn(&profiles{}, s)
+		assert.True(t, result, "Column doesn't exist: %s", s)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.True(t, result, "Table doesn't exist: %s", tableName)
+	constraintName := "uq_profiles_name_version"
+	result = gormDB.Migrator().HasConstraint(&profiles{}, constraintName)
+	assert.True(t, result, "Table doesn't have constraint: %s", constraintName)
+	for _, s := range []string{"uq_controls_catalog_id_oscal_id", "uq_controls_profile_id_catalog_id_oscal_id"} {
+		result = gormDB.Migrator().HasIndex(&controls{}, s)
+		assert.True(t, result, "Index doesn't exist: %s", s)
+	}
+
+	// Results of profile controls move to the catalog control on downgrade
+	catalogID := getUUIDString()
+	profileID := getUUIDString()
+	catalogControlID := getUUIDString()
+	profileControlID := getUUIDString()
+	subjectID, err := insertSubject()
+	if err != nil {
+		t.Fatalf("Unable to create necessary subject: %s", err)
+	}
+	for _, q := range []struct {
+		sql  string
+		args []interface{}
+	}{
+		{"INSERT INTO catalogs (id, name, version) VALUES (?, ?, ?)", []interface{}{catalogID, "catalog", "1.0"}},
+		{"INSERT INTO profiles (id, name, catalog_id) VALUES (?, ?, ?)", []interface{}{profileID, "profile", catalogID}},
+		{
+			"INSERT INTO controls (id, name, catalog_id, oscal_id) VALUES (?, ?, ?, ?)",
+			[]interface{}{catalogControlID, "AC-2", catalogID, "ac-2"},
+		},
+		{
+			"INSERT INTO controls (id, name, profile_id, catalog_id, oscal_id) VALUES (?, ?, ?, ?, ?)",
+			[]interface{}{profileControlID, "AC-2", profileID, catalogID, "ac-2"},
+		},
+		{
+			"INSERT INTO results (id, name, outcome, control_id, subject_id) VALUES (?, ?, ?, ?, ?)",
+			[]interface{}{getUUIDString(), "result", "PASS", profileControlID, subjectID},
+		},
+	} {
+		if err := gormDB.Exec(q.sql, q.args...).Error; err != nil {
+			t.Fatalf("Unable to insert data: %s", err)
+		}
+	}
+
+	if err := m.Migrate(12); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result = gormDB.Migrator().HasColumn(&profiles{}, s)
+		assert.False(t, result, "Column exists after downgrade: %s", s)
+	}
+	var count int64
+	gormDB.Table("results").Where("control_id = ?", catalogControlID).Count(&count)
+	assert.Equal(t, int64(1), count, "expected %d got %d", 1, count)
+	gormDB.Table("controls").Where("id = ?", profileControlID).Count(&count)
+	assert.Equal(t, int64(0), count, "expected %d got %d", 0, count)
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists after downgrade: %s", tableName)
+	result = gormDB.Migrator().HasConstraint(&controls{}, "uq_controls_catalog_id_oscal_id")
+	assert.True(t, result, "Table doesn't have constraint: %s", "uq_controls_catalog_id_oscal_id")
+}
+
+func TestControlPostureIndexesMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type controls struct{}
+	type results struct{}
+	indexes := []string{"idx_controls_name", "idx_controls_oscal_id"}
+	resultIndex := "idx_results_control_id_subject_id_name"
+
+	if err := m.Migrate(13); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range indexes {
+		result := gormDB.Migrator().HasIndex(&controls{}, s)
+		assert.False(t, result, "Index exists prior to migration: %s", s)
+	}
+	result := gormDB.Migrator().HasIndex(&results{}, resultIndex)
+	assert.False(t, result, "Index exists prior to migration: %s", resultIndex)
+
+	if err := m.Migrate(14); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range indexes {
+		result = gormDB.Migrator().HasIndex(&controls{}, s)
+		assert.True(t, result, "Index doesn't exist: %s", s)
+	}
+	result = gormDB.Migrator().HasIndex(&results{}, resultIndex)
+	assert.True(t, result, "Index doesn't exist: %s", resultIndex)
+
+	// Ensure the indexes are removed on downgrade
+	if err := m.Migrate(13); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	for _, s := range indexes {
+		result = gormDB.Migrator().HasIndex(&controls{}, s)
+		assert.False(t, result, "Index exists after downgrade: %s", s)
+	}
+	result = gormDB.Migrator().HasIndex(&results{}, resultIndex)
+	assert.False(t, result, "Index exists after downgrade: %s", resultIndex)
+}
+
+func TestResultsSeqMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type results struct{}
+	columns := []string{"seq", "xid"}
+	indexes := []string{"idx_results_seq", "idx_results_xid"}
+
+	if err := m.Migrate(14); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&results{}, s)
+		assert.False(t, result, "Column exists prior to migration: %s", s)
+	}
+
+	if err := m.Migrate(15); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&results{}, s)
+		assert.True(t, result, "Column doesn't exist: %s", s)
+	}
+	for _, s := range indexes {
+		result := gormDB.Migrator().HasIndex(&results{}, s)
+		assert.True(t, result, "Index doesn't exist: %s", s)
+	}
+
+	// Results record the transaction that wrote them
+	subjectID, err := insertSubject()
+	if err != nil {
+		t.Fatalf("Unable to create necessary subject: %s", err)
+	}
+	id := getUUIDString()
+	err = gormDB.Exec("INSERT INTO results (id, name, subject_id) VALUES (?, ?, ?)", id, "result", subjectID).Error
+	if err != nil {
+		t.Fatalf("Unable to create result: %s", err)
+	}
+	var count int64
+	gormDB.Table("results").Where("id = ? AND xid IS NOT NULL", id).Count(&count)
+	assert.Equal(t, int64(1), count, "expected %d got %d", 1, count)
+
+	if err := m.Migrate(14); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&results{}, s)
+		assert.False(t, result, "Column exists after downgrade: %s", s)
+	}
+	for _, s := range indexes {
+		result := gormDB.Migrator().HasIndex(&results{}, s)
+		assert.False(t, result, "Index exists after downgrade: %s", s)
+	}
+}
+
+func TestDailyRollupsMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type metadata struct{}
+	tables := []string{"daily_compliance_rollups", "stale_rollup_days"}
+	indexName := "idx_metadata_created_at_day"
+
+	if err := m.Migrate(15); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range tables {
+		result := gormDB.Migrator().HasTable(s)
+		assert.False(t, result, "Table exists prior to migration: %s", s)
+	}
+
+	if err := m.Migrate(16); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range tables {
+		result := gormDB.Migrator().HasTable(s)
+		assert.True(t, result, "Table doesn't exist: %s", s)
+	}
+	result := gormDB.Migrator().HasIndex(&metadata{}, indexName)
+	assert.True(t, result, "Index doesn't exist: %s", indexName)
+
+	if err := m.Migrate(15); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	for _, s := range tables {
+		result = gormDB.Migrator().HasTable(s)
+		assert.False(t, result, "Table exists after downgrade: %s", s)
+	}
+	result = gormDB.Migrator().HasIndex(&metadata{}, indexName)
+	assert.False(t, result, "Index exists after downgrade: %s", indexName)
+}
+
+func TestResultIdempotencyKeyMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type results struct{}
+	columns := []string{"idempotency_key", "request_hash"}
+	constraintName := "uq_results_idempotency_key"
+
+	if err := m.Migrate(16); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&results{}, s)
+		assert.False(t, result, "Column exists prior to migration: %s", s)
+	}
+
+	if err := m.Migrate(17); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result := gormDB.Migrator().HasColumn(&results{}, s)
+		assert.True(t, result, "Column doesn't exist: %s", s)
+	}
+	result := gormDB.Migrator().HasConstraint(&results{}, constraintName)
+	assert.True(t, result, "Table doesn't have constraint: %s", constraintName)
+
+	if err := m.Migrate(16); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	for _, s := range columns {
+		result = gormDB.Migrator().HasColumn(&results{}, s)
+		assert.False(t, result, "Column exists after downgrade: %s", s)
+	}
+}
+
+func TestResultHistoryMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type results struct{}
+	tableName := "result_history"
+	indexName := "uq_results_subject_id_name_assessment_id"
+
+	if err := m.Migrate(17); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result := gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists prior to migration: %s", tableName)
+
+	// Report the same check twice during an assessment
+	subjectID, err := insertSubject()
+	if err != nil {
+		t.Fatalf("Unable to create necessary subject: %s", err)
+	}
+	controlID, err := insertControl()
+	if err != nil {
+		t.Fatalf("Unable to create necessary control: %s", err)
+	}
+	assessmentID, err := insertAssessment()
+	if err != nil {
+		t.Fatalf("Unable to create necessary assessment: %s", err)
+	}
+	name := getUUIDString()
+	var ids []string
+	for _, outcome := range []string{"FAIL", "PASS"} {
+		metadataID, err := insertMetadata()
+		if err != nil {
+			t.Fatalf("Unable to create necessary metadata: %s", err)
+		}
+		r := Result{
+			ID: getUUIDString(), Name: name, Outcome: outcome, ControlID: controlID,
+			MetadataID: metadataID, SubjectID: subjectID, AssessmentID: assessmentID,
+		}
+		if err := gormDB.Create(&r).Error; err != nil {
+			t.Fatalf("Unable to create result: %s", err)
+		}
+		ids = append(ids, r.ID)
+	}
+
+	if err := m.Migrate(18); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.True(t, result, "Table doesn't exist: %s", tableName)
+	result = gormDB.Migrator().HasIndex(&results{}, indexName)
+	assert.True(t, result, "Index doesn't exist: %s", indexName)
+
+	// The latest result is kept and the earlier one moves to its history
+	var kept []Result
+	gormDB.Where("assessment_id = ?", assessmentID).Find(&kept)
+	if assert.Len(t, kept, 1) {
+		assert.Equal(t, ids[1], kept[0].ID, "expected %s got %s", ids[1], kept[0].ID)
+		assert.Equal(t, "PASS", kept[0].Outcome, "expected %s got %s", "PASS", kept[0].Outcome)
+	}
+	var history []string
+	gormDB.Table(tableName).Where("result_id = ?", ids[1]).Pluck("outcome", &history)
+	assert.Equal(t, []string{"FAIL"}, history, "expected %v got %v", []string{"FAIL"}, history)
+
+	if err := m.Migrate(17); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists after downgrade: %s", tableName)
+	result = gormDB.Migrator().HasIndex(&results{}, indexName)
+	assert.False(t, result, "Index exists after downgrade: %s", indexName)
+}
+
+func TestRulesMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type results struct{}
+	tableNames := []string{"rules", "rule_controls"}
+	columnName := "rule_id"
+
+	if err := m.Migrate(18); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, tableName := range tableNames {
+		result := gormDB.Migrator().HasTable(tableName)
+		assert.False(t, result, "Table exists prior to migration: %s", tableName)
+	}
+	result := gormDB.Migrator().HasColumn(&results{}, columnName)
+	assert.False(t, result, "Column exists prior to migration: %s", columnName)
+
+	// Existing results get a rule mapped to the control they were
+	// reported for
+	subjectID, err := insertSubject()
+	if err != nil {
+		t.Fatalf("Unable to create necessary subject: %s", err)
+	}
+	controlID, err := insertControl()
+	if err != nil {
+		t.Fatalf("Unable to create necessary control: %s", err)
+	}
+	r := Result{ID: getUUIDString(), Name: getUUIDString(), Outcome: "PASS", ControlID: controlID, SubjectID: subjectID}
+	if err := gormDB.Create(&r).Error; err != nil {
+		t.Fatalf("Unable to create result: %s", err)
+	}
+
+	if err := m.Migrate(19); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	for _, tableName := range tableNames {
+		result = gormDB.Migrator().HasTable(tableName)
+		assert.True(t, result, "Table doesn't exist: %s", tableName)
+	}
+	result = gormDB.Migrator().HasColumn(&results{}, columnName)
+	assert.True(t, result, "Column doesn't exist: %s", columnName)
+
+	var ruleID string
+	gormDB.Table("results").Where("id = ?", r.ID).Pluck("rule_id", &ruleID)
+	var name string
+	gormDB.Table("rules").Where("id = ?", ruleID).Pluck("name", &name)
+	assert.Equal(t, r.Name, name, "expected %s got %s", r.Name, name)
+	var controlIDs []string
+	gormDB.Table("rule_controls").Where("rule_id = ?", ruleID).Pluck("control_id", &controlIDs)
+	assert.Equal(t, []string{controlID}, controlIDs, "expected %v got %v", []string{controlID}, controlIDs)
+
+	if err := m.Migrate(18); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	for _, tableName := range tableNames {
+		result = gormDB.Migrator().HasTable(tableName)
+		assert.False(t, result, "Table exists after downgrade: %s", tableName)
+	}
+	result = gormDB.Migrator().HasColumn(&results{}, columnName)
+	assert.False(t, result, "Column exists after downgrade: %s", columnName)
+}
+
+func TestControlMappingsMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	tableName := "control_mappings"
+
+	if err := m.Migrate(19); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result := gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists prior to migration: %s", tableName)
+
+	if err := m.Migrate(20); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.True(t, result, "Table doesn't exist: %s", tableName)
+
+	// Relationships are limited to the ones we understand
+	sourceID, err := insertControl()
+	if err != nil {
+		t.Fatalf("Unable to create necessary control: %s", err)
+	}
+	targetID, err := insertControl()
+	if err != nil {
+		t.Fatalf("Unable to create necessary control: %s", err)
+	}
+	insert := "INSERT INTO control_mappings (id, source_control_id, target_control_id, relationship) VALUES (?, ?, ?, ?)"
+	err = gormDB.Exec(insert, getUUIDString(), sourceID, targetID, "SIMILAR").Error
+	assert.NotNil(t, err, "Created a mapping with an unknown relationship")
+	err = gormDB.Exec(insert, getUUIDString(), sourceID, targetID, "EQUIVALENT").Error
+	assert.Nil(t, err, "Unable to create mapping: %s", err)
+
+	if err := m.Migrate(19); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists after downgrade: %s", tableName)
+}
+
+func TestControlHierarchyMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	type controls struct{}
+	tableName := "control_families"
+	columnNames := []string{"parent_id", "family_id", "position"}
+
+	if err := m.Migrate(20); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result := gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists prior to migration: %s", tableName)
+	for _, columnName := range columnNames {
+		result = gormDB.Migrator().HasColumn(&controls{}, columnName)
+		assert.False(t, result, "Column exists prior to migration: %s", columnName)
+	}
+
+	if err := m.Migrate(21); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.True(t, result, "Table doesn't exist: %s", tableName)
+	for _, columnName := range columnNames {
+		result = gormDB.Migrator().HasColumn(&controls{}, columnName)
+		assert.True(t, result, "Column doesn't exist: %s", columnName)
+	}
+
+	// Removing a control leaves its enhancements in place
+	parentID, err := insertControl()
+	if err != nil {
+		t.Fatalf("Unable to create necessary control: %s", err)
+	}
+	childID, err := insertControl()
+	if err != nil {
+		t.Fatalf("Unable to create necessary control: %s", err)
+	}
+	err = gormDB.Exec("UPDATE controls SET parent_id = ? WHERE id = ?", parentID, childID).Error
+	assert.Nil(t, err, "Unable to set control parent: %s", err)
+	err = gormDB.Exec("DELETE FROM controls WHERE id = ?", parentID).Error
+	assert.Nil(t, err, "Unable to delete parent control: %s", err)
+	var parents []*string
+	gormDB.Table("controls").Where("id = ?", childID).Pluck("parent_id", &parents)
+	if assert.Len(t, parents, 1) {
+		assert.Nil(t, parents[0], "expected no parent got %v", parents[0])
+	}
+
+	if err := m.Migrate(20); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists after downgrade: %s", tableName)
+	for _, columnName := range columnNames {
+		result = gormDB.Migrator().HasColumn(&controls{}, columnName)
+		assert.False(t, result, "Column exists after downgrade: %s", columnName)
+	}
+}
+
+func TestExceptionsMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	tableName := "exceptions"
+
+	if err := m.Migrate(21); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result := gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists prior to migration: %s", tableName)
+
+	if err := m.Migrate(22); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.True(t, result, "Table doesn't exist: %s", tableName)
+
+	// Exceptions cover either a control or a rule
+	subjectID, err := insertSubject()
+	if err != nil {
+		t.Fatalf("Unable to create necessary subject: %s", err)
+	}
+	controlID, err := insertControl()
+	if err != nil {
+		t.Fatalf("Unable to create necessary control: %s", err)
+	}
+	insert := "INSERT INTO exceptions (id, subject_id, control_id, justification, approver, expires_at, created_at) " +
+		"VALUES (?, ?, ?, ?, ?, now() + interval '1 day', now())"
+	err = gormDB.Exec(insert, getUUIDString(), subjectID, nil, "justification", "approver").Error
+	assert.NotNil(t, err, "Created an exception without a control or rule")
+	err = gormDB.Exec(insert, getUUIDString(), subjectID, controlID, "justification", "approver").Error
+	assert.Nil(t, err, "Unable to create exception: %s", err)
+
+	if err := m.Migrate(21); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists after downgrade: %s", tableName)
+}
+
+func TestEvidenceMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	tableName := "evidence"
+
+	if err := m.Migrate(22); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result := gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists prior to migration: %s", tableName)
+
+	if err := m.Migrate(23); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.True(t, result, "Table doesn't exist: %s", tableName)
+
+	// Evidence is attached to either a result or an assessment
+	assessmentID, err := insertAssessment()
+	if err != nil {
+		t.Fatalf("Unable to create necessary assessment: %s", err)
+	}
+	insert := "INSERT INTO evidence (id, result_id, assessment_id, name, content_type, sha256, size, created_at) " +
+		"VALUES (?, ?, ?, ?, ?, ?, ?, now())"
+	digest := strings.Repeat("0", 64)
+	err = gormDB.Exec(insert, getUUIDString(), nil, nil, "name", "text/plain", digest, 0).Error
+	assert.NotNil(t, err, "Created evidence without a result or assessment")
+	err = gormDB.Exec(insert, getUUIDString(), nil, assessmentID, "name", "text/plain", digest, 0).Error
+	assert.Nil(t, err, "Unable to create evidence: %s", err)
+
+	if err := m.Migrate(22); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists after downgrade: %s", tableName)
+}
+
+func TestSubjectLabelsMigration(t *testing.T) { // nolint:paralleltest // database tests should run serially
+	m := getMigrationHelper(t)
+	gormDB := getGormHelper()
+
+	tableName := "subject_labels"
+
+	if err := m.Migrate(23); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result := gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists prior to migration: %s", tableName)
+
+	if err := m.Migrate(24); err != nil {
+		t.Fatalf("Unable to upgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.True(t, result, "Table doesn't exist: %s", tableName)
+
+	// A subject has a single value for each key
+	subjectID, err := insertSubject()
+	if err != nil {
+		t.Fatalf("Unable to create necessary subject: %s", err)
+	}
+	insert := "INSERT INTO subject_labels (subject_id, key, value) VALUES (?, ?, ?)"
+	err = gormDB.Exec(insert, subjectID, "env", "prod").Error
+	assert.Nil(t, err, "Unable to create label: %s", err)
+	err = gormDB.Exec(insert, subjectID, "env", "staging").Error
+	assert.NotNil(t, err, "Created a second value for a label")
+
+	if err := m.Migrate(23); err != nil {
+		t.Fatalf("Unable to downgrade database: %s", err)
+	}
+	result = gormDB.Migrator().HasTable(tableName)
+	assert.False(t, result, "Table exists after downgrade: %s", tableName)
+}
@@ -1,9 +1,11 @@
 package tests
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -11,6 +13,11 @@ import (
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file" // Necessary to invoke migrations locally in the repository
 	"github.com/google/uuid"
+	api "github.com/rhmdnd/compserv/pkg/api"
+	evidence "github.com/rhmdnd/compserv/pkg/evidence"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
 	gorm_postgres "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -140,6 +147,60 @@ func getGormHelper() *gorm.DB {
 	return gormDB
 }
 
+// getServerHelper starts the compliance service on an in-memory listener and
+// returns the dial options needed to connect to it.
+func getServerHelper(t *testing.T) []grpc.DialOption {
+	t.Helper()
+	bufSize := 1024 * 1024
+	lis := bufconn.Listen(bufSize)
+	grpcServer := grpc.NewServer()
+	store, err := evidence.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("Unable to create evidence store: %s", err)
+	}
+	server := api.NewServer(getGormHelper(), api.WithEvidenceStore(store, maxEvidenceSize))
+	api.RegisterComplianceServiceServer(grpcServer, server)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			t.Logf("Server exited with error: %s", err)
+		}
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+	return []grpc.DialOption{grpc.WithContextDialer(dialer), grpc.WithTransportCredentials(insecure.NewCredentials())}
+}
+
+// getClientHelper returns a client connected to the compliance service. This
+// is useful for testing streaming RPCs, which can't be invoked directly on
+// the server.
+func getClientHelper(t *testing.T) api.ComplianceServiceClient {
+	t.Helper()
+	conn, err := grpc.DialContext(context.Background(), "bufnet", getServerHelper(t)...)
+	if err != nil {
+		t.Fatalf("Unable to connect to the test server: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return api.NewComplianceServiceClient(conn)
+}
+
+// getGatewayHelper serves the REST gateway for the compliance service and
+// returns its URL.
+func getGatewayHelper(t *testing.T) string {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	gateway, err := api.NewGateway(ctx, "bufnet", getServerHelper(t)...)
+	if err != nil {
+		t.Fatalf("Unable to create the REST gateway: %s", err)
+	}
+	ts := httptest.NewServer(gateway)
+	t.Cleanup(func() {
+		ts.Close()
+		cancel()
+	})
+	return ts.URL
+}
+
 func getUUIDString() string {
 	value, _ := uuid.NewRandom()
 	return value.String()